@@ -35,33 +35,179 @@ const (
 const (
 	// ArchiveServiceReadProcedure is the fully-qualified name of the ArchiveService's Read RPC.
 	ArchiveServiceReadProcedure = "/v1.ArchiveService/Read"
+	// ArchiveServiceResolveIDProcedure is the fully-qualified name of the ArchiveService's ResolveID
+	// RPC.
+	ArchiveServiceResolveIDProcedure = "/v1.ArchiveService/ResolveID"
+	// ArchiveServiceLocateProcedure is the fully-qualified name of the ArchiveService's Locate RPC.
+	ArchiveServiceLocateProcedure = "/v1.ArchiveService/Locate"
 	// ArchiveServiceCreateProcedure is the fully-qualified name of the ArchiveService's Create RPC.
 	ArchiveServiceCreateProcedure = "/v1.ArchiveService/Create"
+	// ArchiveServiceUpdateProcedure is the fully-qualified name of the ArchiveService's Update RPC.
+	ArchiveServiceUpdateProcedure = "/v1.ArchiveService/Update"
+	// ArchiveServiceAddImageProcedure is the fully-qualified name of the ArchiveService's AddImage RPC.
+	ArchiveServiceAddImageProcedure = "/v1.ArchiveService/AddImage"
+	// ArchiveServiceReorderImagesProcedure is the fully-qualified name of the ArchiveService's
+	// ReorderImages RPC.
+	ArchiveServiceReorderImagesProcedure = "/v1.ArchiveService/ReorderImages"
+	// ArchiveServiceSetPrimaryImageProcedure is the fully-qualified name of the ArchiveService's
+	// SetPrimaryImage RPC.
+	ArchiveServiceSetPrimaryImageProcedure = "/v1.ArchiveService/SetPrimaryImage"
+	// ArchiveServiceRemoveImageProcedure is the fully-qualified name of the ArchiveService's
+	// RemoveImage RPC.
+	ArchiveServiceRemoveImageProcedure = "/v1.ArchiveService/RemoveImage"
+	// ArchiveServiceListAttachmentsProcedure is the fully-qualified name of the ArchiveService's
+	// ListAttachments RPC.
+	ArchiveServiceListAttachmentsProcedure = "/v1.ArchiveService/ListAttachments"
+	// ArchiveServiceUploadAttachmentProcedure is the fully-qualified name of the ArchiveService's
+	// UploadAttachment RPC.
+	ArchiveServiceUploadAttachmentProcedure = "/v1.ArchiveService/UploadAttachment"
+	// ArchiveServiceDownloadAttachmentProcedure is the fully-qualified name of the ArchiveService's
+	// DownloadAttachment RPC.
+	ArchiveServiceDownloadAttachmentProcedure = "/v1.ArchiveService/DownloadAttachment"
+	// ArchiveServiceAdjustQuantityProcedure is the fully-qualified name of the ArchiveService's
+	// AdjustQuantity RPC.
+	ArchiveServiceAdjustQuantityProcedure = "/v1.ArchiveService/AdjustQuantity"
+	// ArchiveServiceQuantityHistoryProcedure is the fully-qualified name of the ArchiveService's
+	// QuantityHistory RPC.
+	ArchiveServiceQuantityHistoryProcedure = "/v1.ArchiveService/QuantityHistory"
+	// ArchiveServiceLowStockProcedure is the fully-qualified name of the ArchiveService's LowStock RPC.
+	ArchiveServiceLowStockProcedure = "/v1.ArchiveService/LowStock"
+	// ArchiveServiceUpcomingProcedure is the fully-qualified name of the ArchiveService's Upcoming RPC.
+	ArchiveServiceUpcomingProcedure = "/v1.ArchiveService/Upcoming"
+	// ArchiveServiceLogMaintenanceProcedure is the fully-qualified name of the ArchiveService's
+	// LogMaintenance RPC.
+	ArchiveServiceLogMaintenanceProcedure = "/v1.ArchiveService/LogMaintenance"
+	// ArchiveServiceMaintenanceHistoryProcedure is the fully-qualified name of the ArchiveService's
+	// MaintenanceHistory RPC.
+	ArchiveServiceMaintenanceHistoryProcedure = "/v1.ArchiveService/MaintenanceHistory"
+	// ArchiveServiceDueMaintenanceProcedure is the fully-qualified name of the ArchiveService's
+	// DueMaintenance RPC.
+	ArchiveServiceDueMaintenanceProcedure = "/v1.ArchiveService/DueMaintenance"
+	// ArchiveServiceCheckOutProcedure is the fully-qualified name of the ArchiveService's CheckOut RPC.
+	ArchiveServiceCheckOutProcedure = "/v1.ArchiveService/CheckOut"
+	// ArchiveServiceCheckInProcedure is the fully-qualified name of the ArchiveService's CheckIn RPC.
+	ArchiveServiceCheckInProcedure = "/v1.ArchiveService/CheckIn"
+	// ArchiveServiceListCheckedOutProcedure is the fully-qualified name of the ArchiveService's
+	// ListCheckedOut RPC.
+	ArchiveServiceListCheckedOutProcedure = "/v1.ArchiveService/ListCheckedOut"
 	// ArchiveServiceMoveProcedure is the fully-qualified name of the ArchiveService's Move RPC.
 	ArchiveServiceMoveProcedure = "/v1.ArchiveService/Move"
 	// ArchiveServiceDeleteProcedure is the fully-qualified name of the ArchiveService's Delete RPC.
 	ArchiveServiceDeleteProcedure = "/v1.ArchiveService/Delete"
+	// ArchiveServiceListTrashProcedure is the fully-qualified name of the ArchiveService's ListTrash
+	// RPC.
+	ArchiveServiceListTrashProcedure = "/v1.ArchiveService/ListTrash"
+	// ArchiveServiceRestoreProcedure is the fully-qualified name of the ArchiveService's Restore RPC.
+	ArchiveServiceRestoreProcedure = "/v1.ArchiveService/Restore"
+	// ArchiveServiceEmptyTrashProcedure is the fully-qualified name of the ArchiveService's EmptyTrash
+	// RPC.
+	ArchiveServiceEmptyTrashProcedure = "/v1.ArchiveService/EmptyTrash"
 	// ArchiveServiceSearchProcedure is the fully-qualified name of the ArchiveService's Search RPC.
 	ArchiveServiceSearchProcedure = "/v1.ArchiveService/Search"
+	// ArchiveServiceHistoryProcedure is the fully-qualified name of the ArchiveService's History RPC.
+	ArchiveServiceHistoryProcedure = "/v1.ArchiveService/History"
+	// ArchiveServiceUndoProcedure is the fully-qualified name of the ArchiveService's Undo RPC.
+	ArchiveServiceUndoProcedure = "/v1.ArchiveService/Undo"
+	// ArchiveServiceRedoProcedure is the fully-qualified name of the ArchiveService's Redo RPC.
+	ArchiveServiceRedoProcedure = "/v1.ArchiveService/Redo"
+	// ArchiveServiceListRevisionsProcedure is the fully-qualified name of the ArchiveService's
+	// ListRevisions RPC.
+	ArchiveServiceListRevisionsProcedure = "/v1.ArchiveService/ListRevisions"
+	// ArchiveServiceReadAtProcedure is the fully-qualified name of the ArchiveService's ReadAt RPC.
+	ArchiveServiceReadAtProcedure = "/v1.ArchiveService/ReadAt"
+	// ArchiveServiceStartAuditProcedure is the fully-qualified name of the ArchiveService's StartAudit
+	// RPC.
+	ArchiveServiceStartAuditProcedure = "/v1.ArchiveService/StartAudit"
+	// ArchiveServiceMarkSeenProcedure is the fully-qualified name of the ArchiveService's MarkSeen RPC.
+	ArchiveServiceMarkSeenProcedure = "/v1.ArchiveService/MarkSeen"
+	// ArchiveServiceFinishAuditProcedure is the fully-qualified name of the ArchiveService's
+	// FinishAudit RPC.
+	ArchiveServiceFinishAuditProcedure = "/v1.ArchiveService/FinishAudit"
+	// ArchiveServiceWatchProcedure is the fully-qualified name of the ArchiveService's Watch RPC.
+	ArchiveServiceWatchProcedure = "/v1.ArchiveService/Watch"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	archiveServiceServiceDescriptor      = v1.File_v1_api_proto.Services().ByName("ArchiveService")
-	archiveServiceReadMethodDescriptor   = archiveServiceServiceDescriptor.Methods().ByName("Read")
-	archiveServiceCreateMethodDescriptor = archiveServiceServiceDescriptor.Methods().ByName("Create")
-	archiveServiceMoveMethodDescriptor   = archiveServiceServiceDescriptor.Methods().ByName("Move")
-	archiveServiceDeleteMethodDescriptor = archiveServiceServiceDescriptor.Methods().ByName("Delete")
-	archiveServiceSearchMethodDescriptor = archiveServiceServiceDescriptor.Methods().ByName("Search")
+	archiveServiceServiceDescriptor                  = v1.File_v1_api_proto.Services().ByName("ArchiveService")
+	archiveServiceReadMethodDescriptor               = archiveServiceServiceDescriptor.Methods().ByName("Read")
+	archiveServiceResolveIDMethodDescriptor          = archiveServiceServiceDescriptor.Methods().ByName("ResolveID")
+	archiveServiceLocateMethodDescriptor             = archiveServiceServiceDescriptor.Methods().ByName("Locate")
+	archiveServiceCreateMethodDescriptor             = archiveServiceServiceDescriptor.Methods().ByName("Create")
+	archiveServiceUpdateMethodDescriptor             = archiveServiceServiceDescriptor.Methods().ByName("Update")
+	archiveServiceAddImageMethodDescriptor           = archiveServiceServiceDescriptor.Methods().ByName("AddImage")
+	archiveServiceReorderImagesMethodDescriptor      = archiveServiceServiceDescriptor.Methods().ByName("ReorderImages")
+	archiveServiceSetPrimaryImageMethodDescriptor    = archiveServiceServiceDescriptor.Methods().ByName("SetPrimaryImage")
+	archiveServiceRemoveImageMethodDescriptor        = archiveServiceServiceDescriptor.Methods().ByName("RemoveImage")
+	archiveServiceListAttachmentsMethodDescriptor    = archiveServiceServiceDescriptor.Methods().ByName("ListAttachments")
+	archiveServiceUploadAttachmentMethodDescriptor   = archiveServiceServiceDescriptor.Methods().ByName("UploadAttachment")
+	archiveServiceDownloadAttachmentMethodDescriptor = archiveServiceServiceDescriptor.Methods().ByName("DownloadAttachment")
+	archiveServiceAdjustQuantityMethodDescriptor     = archiveServiceServiceDescriptor.Methods().ByName("AdjustQuantity")
+	archiveServiceQuantityHistoryMethodDescriptor    = archiveServiceServiceDescriptor.Methods().ByName("QuantityHistory")
+	archiveServiceLowStockMethodDescriptor           = archiveServiceServiceDescriptor.Methods().ByName("LowStock")
+	archiveServiceUpcomingMethodDescriptor           = archiveServiceServiceDescriptor.Methods().ByName("Upcoming")
+	archiveServiceLogMaintenanceMethodDescriptor     = archiveServiceServiceDescriptor.Methods().ByName("LogMaintenance")
+	archiveServiceMaintenanceHistoryMethodDescriptor = archiveServiceServiceDescriptor.Methods().ByName("MaintenanceHistory")
+	archiveServiceDueMaintenanceMethodDescriptor     = archiveServiceServiceDescriptor.Methods().ByName("DueMaintenance")
+	archiveServiceCheckOutMethodDescriptor           = archiveServiceServiceDescriptor.Methods().ByName("CheckOut")
+	archiveServiceCheckInMethodDescriptor            = archiveServiceServiceDescriptor.Methods().ByName("CheckIn")
+	archiveServiceListCheckedOutMethodDescriptor     = archiveServiceServiceDescriptor.Methods().ByName("ListCheckedOut")
+	archiveServiceMoveMethodDescriptor               = archiveServiceServiceDescriptor.Methods().ByName("Move")
+	archiveServiceDeleteMethodDescriptor             = archiveServiceServiceDescriptor.Methods().ByName("Delete")
+	archiveServiceListTrashMethodDescriptor          = archiveServiceServiceDescriptor.Methods().ByName("ListTrash")
+	archiveServiceRestoreMethodDescriptor            = archiveServiceServiceDescriptor.Methods().ByName("Restore")
+	archiveServiceEmptyTrashMethodDescriptor         = archiveServiceServiceDescriptor.Methods().ByName("EmptyTrash")
+	archiveServiceSearchMethodDescriptor             = archiveServiceServiceDescriptor.Methods().ByName("Search")
+	archiveServiceHistoryMethodDescriptor            = archiveServiceServiceDescriptor.Methods().ByName("History")
+	archiveServiceUndoMethodDescriptor               = archiveServiceServiceDescriptor.Methods().ByName("Undo")
+	archiveServiceRedoMethodDescriptor               = archiveServiceServiceDescriptor.Methods().ByName("Redo")
+	archiveServiceListRevisionsMethodDescriptor      = archiveServiceServiceDescriptor.Methods().ByName("ListRevisions")
+	archiveServiceReadAtMethodDescriptor             = archiveServiceServiceDescriptor.Methods().ByName("ReadAt")
+	archiveServiceStartAuditMethodDescriptor         = archiveServiceServiceDescriptor.Methods().ByName("StartAudit")
+	archiveServiceMarkSeenMethodDescriptor           = archiveServiceServiceDescriptor.Methods().ByName("MarkSeen")
+	archiveServiceFinishAuditMethodDescriptor        = archiveServiceServiceDescriptor.Methods().ByName("FinishAudit")
+	archiveServiceWatchMethodDescriptor              = archiveServiceServiceDescriptor.Methods().ByName("Watch")
 )
 
 // ArchiveServiceClient is a client for the v1.ArchiveService service.
 type ArchiveServiceClient interface {
 	Read(context.Context, *connect.Request[v1.ReadRequest]) (*connect.Response[v1.ReadResponse], error)
+	ResolveID(context.Context, *connect.Request[v1.ResolveIDRequest]) (*connect.Response[v1.ResolveIDResponse], error)
+	Locate(context.Context, *connect.Request[v1.LocateRequest]) (*connect.Response[v1.LocateResponse], error)
 	Create(context.Context, *connect.Request[v1.CreateRequest]) (*connect.Response[v1.CreateResponse], error)
+	Update(context.Context, *connect.Request[v1.UpdateRequest]) (*connect.Response[v1.UpdateResponse], error)
+	AddImage(context.Context, *connect.Request[v1.AddImageRequest]) (*connect.Response[v1.AddImageResponse], error)
+	ReorderImages(context.Context, *connect.Request[v1.ReorderImagesRequest]) (*connect.Response[v1.ReorderImagesResponse], error)
+	SetPrimaryImage(context.Context, *connect.Request[v1.SetPrimaryImageRequest]) (*connect.Response[v1.SetPrimaryImageResponse], error)
+	RemoveImage(context.Context, *connect.Request[v1.RemoveImageRequest]) (*connect.Response[v1.RemoveImageResponse], error)
+	ListAttachments(context.Context, *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error)
+	UploadAttachment(context.Context) *connect.ClientStreamForClient[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse]
+	DownloadAttachment(context.Context, *connect.Request[v1.DownloadAttachmentRequest]) (*connect.ServerStreamForClient[v1.DownloadAttachmentResponse], error)
+	AdjustQuantity(context.Context, *connect.Request[v1.AdjustQuantityRequest]) (*connect.Response[v1.AdjustQuantityResponse], error)
+	QuantityHistory(context.Context, *connect.Request[v1.QuantityHistoryRequest]) (*connect.Response[v1.QuantityHistoryResponse], error)
+	LowStock(context.Context, *connect.Request[v1.LowStockRequest]) (*connect.Response[v1.LowStockResponse], error)
+	Upcoming(context.Context, *connect.Request[v1.UpcomingRequest]) (*connect.Response[v1.UpcomingResponse], error)
+	LogMaintenance(context.Context, *connect.Request[v1.LogMaintenanceRequest]) (*connect.Response[v1.LogMaintenanceResponse], error)
+	MaintenanceHistory(context.Context, *connect.Request[v1.MaintenanceHistoryRequest]) (*connect.Response[v1.MaintenanceHistoryResponse], error)
+	DueMaintenance(context.Context, *connect.Request[v1.DueMaintenanceRequest]) (*connect.Response[v1.DueMaintenanceResponse], error)
+	CheckOut(context.Context, *connect.Request[v1.CheckOutRequest]) (*connect.Response[v1.CheckOutResponse], error)
+	CheckIn(context.Context, *connect.Request[v1.CheckInRequest]) (*connect.Response[v1.CheckInResponse], error)
+	ListCheckedOut(context.Context, *connect.Request[v1.ListCheckedOutRequest]) (*connect.Response[v1.ListCheckedOutResponse], error)
 	Move(context.Context, *connect.Request[v1.MoveRequest]) (*connect.Response[v1.MoveResponse], error)
 	Delete(context.Context, *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error)
+	ListTrash(context.Context, *connect.Request[v1.ListTrashRequest]) (*connect.Response[v1.ListTrashResponse], error)
+	Restore(context.Context, *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.RestoreResponse], error)
+	EmptyTrash(context.Context, *connect.Request[v1.EmptyTrashRequest]) (*connect.Response[v1.EmptyTrashResponse], error)
 	Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error)
+	History(context.Context, *connect.Request[v1.HistoryRequest]) (*connect.Response[v1.HistoryResponse], error)
+	Undo(context.Context, *connect.Request[v1.UndoRequest]) (*connect.Response[v1.UndoResponse], error)
+	Redo(context.Context, *connect.Request[v1.RedoRequest]) (*connect.Response[v1.RedoResponse], error)
+	ListRevisions(context.Context, *connect.Request[v1.ListRevisionsRequest]) (*connect.Response[v1.ListRevisionsResponse], error)
+	ReadAt(context.Context, *connect.Request[v1.ReadAtRequest]) (*connect.Response[v1.ReadAtResponse], error)
+	StartAudit(context.Context, *connect.Request[v1.StartAuditRequest]) (*connect.Response[v1.StartAuditResponse], error)
+	MarkSeen(context.Context, *connect.Request[v1.MarkSeenRequest]) (*connect.Response[v1.MarkSeenResponse], error)
+	FinishAudit(context.Context, *connect.Request[v1.FinishAuditRequest]) (*connect.Response[v1.FinishAuditResponse], error)
+	Watch(context.Context, *connect.Request[v1.WatchRequest]) (*connect.ServerStreamForClient[v1.WatchResponse], error)
 }
 
 // NewArchiveServiceClient constructs a client for the v1.ArchiveService service. By default, it
@@ -80,12 +226,132 @@ func NewArchiveServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(archiveServiceReadMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		resolveID: connect.NewClient[v1.ResolveIDRequest, v1.ResolveIDResponse](
+			httpClient,
+			baseURL+ArchiveServiceResolveIDProcedure,
+			connect.WithSchema(archiveServiceResolveIDMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		locate: connect.NewClient[v1.LocateRequest, v1.LocateResponse](
+			httpClient,
+			baseURL+ArchiveServiceLocateProcedure,
+			connect.WithSchema(archiveServiceLocateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		create: connect.NewClient[v1.CreateRequest, v1.CreateResponse](
 			httpClient,
 			baseURL+ArchiveServiceCreateProcedure,
 			connect.WithSchema(archiveServiceCreateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		update: connect.NewClient[v1.UpdateRequest, v1.UpdateResponse](
+			httpClient,
+			baseURL+ArchiveServiceUpdateProcedure,
+			connect.WithSchema(archiveServiceUpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		addImage: connect.NewClient[v1.AddImageRequest, v1.AddImageResponse](
+			httpClient,
+			baseURL+ArchiveServiceAddImageProcedure,
+			connect.WithSchema(archiveServiceAddImageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		reorderImages: connect.NewClient[v1.ReorderImagesRequest, v1.ReorderImagesResponse](
+			httpClient,
+			baseURL+ArchiveServiceReorderImagesProcedure,
+			connect.WithSchema(archiveServiceReorderImagesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setPrimaryImage: connect.NewClient[v1.SetPrimaryImageRequest, v1.SetPrimaryImageResponse](
+			httpClient,
+			baseURL+ArchiveServiceSetPrimaryImageProcedure,
+			connect.WithSchema(archiveServiceSetPrimaryImageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeImage: connect.NewClient[v1.RemoveImageRequest, v1.RemoveImageResponse](
+			httpClient,
+			baseURL+ArchiveServiceRemoveImageProcedure,
+			connect.WithSchema(archiveServiceRemoveImageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAttachments: connect.NewClient[v1.ListAttachmentsRequest, v1.ListAttachmentsResponse](
+			httpClient,
+			baseURL+ArchiveServiceListAttachmentsProcedure,
+			connect.WithSchema(archiveServiceListAttachmentsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		uploadAttachment: connect.NewClient[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse](
+			httpClient,
+			baseURL+ArchiveServiceUploadAttachmentProcedure,
+			connect.WithSchema(archiveServiceUploadAttachmentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		downloadAttachment: connect.NewClient[v1.DownloadAttachmentRequest, v1.DownloadAttachmentResponse](
+			httpClient,
+			baseURL+ArchiveServiceDownloadAttachmentProcedure,
+			connect.WithSchema(archiveServiceDownloadAttachmentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		adjustQuantity: connect.NewClient[v1.AdjustQuantityRequest, v1.AdjustQuantityResponse](
+			httpClient,
+			baseURL+ArchiveServiceAdjustQuantityProcedure,
+			connect.WithSchema(archiveServiceAdjustQuantityMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		quantityHistory: connect.NewClient[v1.QuantityHistoryRequest, v1.QuantityHistoryResponse](
+			httpClient,
+			baseURL+ArchiveServiceQuantityHistoryProcedure,
+			connect.WithSchema(archiveServiceQuantityHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		lowStock: connect.NewClient[v1.LowStockRequest, v1.LowStockResponse](
+			httpClient,
+			baseURL+ArchiveServiceLowStockProcedure,
+			connect.WithSchema(archiveServiceLowStockMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		upcoming: connect.NewClient[v1.UpcomingRequest, v1.UpcomingResponse](
+			httpClient,
+			baseURL+ArchiveServiceUpcomingProcedure,
+			connect.WithSchema(archiveServiceUpcomingMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		logMaintenance: connect.NewClient[v1.LogMaintenanceRequest, v1.LogMaintenanceResponse](
+			httpClient,
+			baseURL+ArchiveServiceLogMaintenanceProcedure,
+			connect.WithSchema(archiveServiceLogMaintenanceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		maintenanceHistory: connect.NewClient[v1.MaintenanceHistoryRequest, v1.MaintenanceHistoryResponse](
+			httpClient,
+			baseURL+ArchiveServiceMaintenanceHistoryProcedure,
+			connect.WithSchema(archiveServiceMaintenanceHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		dueMaintenance: connect.NewClient[v1.DueMaintenanceRequest, v1.DueMaintenanceResponse](
+			httpClient,
+			baseURL+ArchiveServiceDueMaintenanceProcedure,
+			connect.WithSchema(archiveServiceDueMaintenanceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		checkOut: connect.NewClient[v1.CheckOutRequest, v1.CheckOutResponse](
+			httpClient,
+			baseURL+ArchiveServiceCheckOutProcedure,
+			connect.WithSchema(archiveServiceCheckOutMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		checkIn: connect.NewClient[v1.CheckInRequest, v1.CheckInResponse](
+			httpClient,
+			baseURL+ArchiveServiceCheckInProcedure,
+			connect.WithSchema(archiveServiceCheckInMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listCheckedOut: connect.NewClient[v1.ListCheckedOutRequest, v1.ListCheckedOutResponse](
+			httpClient,
+			baseURL+ArchiveServiceListCheckedOutProcedure,
+			connect.WithSchema(archiveServiceListCheckedOutMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		move: connect.NewClient[v1.MoveRequest, v1.MoveResponse](
 			httpClient,
 			baseURL+ArchiveServiceMoveProcedure,
@@ -98,22 +364,126 @@ func NewArchiveServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(archiveServiceDeleteMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listTrash: connect.NewClient[v1.ListTrashRequest, v1.ListTrashResponse](
+			httpClient,
+			baseURL+ArchiveServiceListTrashProcedure,
+			connect.WithSchema(archiveServiceListTrashMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		restore: connect.NewClient[v1.RestoreRequest, v1.RestoreResponse](
+			httpClient,
+			baseURL+ArchiveServiceRestoreProcedure,
+			connect.WithSchema(archiveServiceRestoreMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		emptyTrash: connect.NewClient[v1.EmptyTrashRequest, v1.EmptyTrashResponse](
+			httpClient,
+			baseURL+ArchiveServiceEmptyTrashProcedure,
+			connect.WithSchema(archiveServiceEmptyTrashMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		search: connect.NewClient[v1.SearchRequest, v1.SearchResponse](
 			httpClient,
 			baseURL+ArchiveServiceSearchProcedure,
 			connect.WithSchema(archiveServiceSearchMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		history: connect.NewClient[v1.HistoryRequest, v1.HistoryResponse](
+			httpClient,
+			baseURL+ArchiveServiceHistoryProcedure,
+			connect.WithSchema(archiveServiceHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		undo: connect.NewClient[v1.UndoRequest, v1.UndoResponse](
+			httpClient,
+			baseURL+ArchiveServiceUndoProcedure,
+			connect.WithSchema(archiveServiceUndoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		redo: connect.NewClient[v1.RedoRequest, v1.RedoResponse](
+			httpClient,
+			baseURL+ArchiveServiceRedoProcedure,
+			connect.WithSchema(archiveServiceRedoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listRevisions: connect.NewClient[v1.ListRevisionsRequest, v1.ListRevisionsResponse](
+			httpClient,
+			baseURL+ArchiveServiceListRevisionsProcedure,
+			connect.WithSchema(archiveServiceListRevisionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		readAt: connect.NewClient[v1.ReadAtRequest, v1.ReadAtResponse](
+			httpClient,
+			baseURL+ArchiveServiceReadAtProcedure,
+			connect.WithSchema(archiveServiceReadAtMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		startAudit: connect.NewClient[v1.StartAuditRequest, v1.StartAuditResponse](
+			httpClient,
+			baseURL+ArchiveServiceStartAuditProcedure,
+			connect.WithSchema(archiveServiceStartAuditMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		markSeen: connect.NewClient[v1.MarkSeenRequest, v1.MarkSeenResponse](
+			httpClient,
+			baseURL+ArchiveServiceMarkSeenProcedure,
+			connect.WithSchema(archiveServiceMarkSeenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		finishAudit: connect.NewClient[v1.FinishAuditRequest, v1.FinishAuditResponse](
+			httpClient,
+			baseURL+ArchiveServiceFinishAuditProcedure,
+			connect.WithSchema(archiveServiceFinishAuditMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		watch: connect.NewClient[v1.WatchRequest, v1.WatchResponse](
+			httpClient,
+			baseURL+ArchiveServiceWatchProcedure,
+			connect.WithSchema(archiveServiceWatchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // archiveServiceClient implements ArchiveServiceClient.
 type archiveServiceClient struct {
-	read   *connect.Client[v1.ReadRequest, v1.ReadResponse]
-	create *connect.Client[v1.CreateRequest, v1.CreateResponse]
-	move   *connect.Client[v1.MoveRequest, v1.MoveResponse]
-	delete *connect.Client[v1.DeleteRequest, v1.DeleteResponse]
-	search *connect.Client[v1.SearchRequest, v1.SearchResponse]
+	read               *connect.Client[v1.ReadRequest, v1.ReadResponse]
+	resolveID          *connect.Client[v1.ResolveIDRequest, v1.ResolveIDResponse]
+	locate             *connect.Client[v1.LocateRequest, v1.LocateResponse]
+	create             *connect.Client[v1.CreateRequest, v1.CreateResponse]
+	update             *connect.Client[v1.UpdateRequest, v1.UpdateResponse]
+	addImage           *connect.Client[v1.AddImageRequest, v1.AddImageResponse]
+	reorderImages      *connect.Client[v1.ReorderImagesRequest, v1.ReorderImagesResponse]
+	setPrimaryImage    *connect.Client[v1.SetPrimaryImageRequest, v1.SetPrimaryImageResponse]
+	removeImage        *connect.Client[v1.RemoveImageRequest, v1.RemoveImageResponse]
+	listAttachments    *connect.Client[v1.ListAttachmentsRequest, v1.ListAttachmentsResponse]
+	uploadAttachment   *connect.Client[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse]
+	downloadAttachment *connect.Client[v1.DownloadAttachmentRequest, v1.DownloadAttachmentResponse]
+	adjustQuantity     *connect.Client[v1.AdjustQuantityRequest, v1.AdjustQuantityResponse]
+	quantityHistory    *connect.Client[v1.QuantityHistoryRequest, v1.QuantityHistoryResponse]
+	lowStock           *connect.Client[v1.LowStockRequest, v1.LowStockResponse]
+	upcoming           *connect.Client[v1.UpcomingRequest, v1.UpcomingResponse]
+	logMaintenance     *connect.Client[v1.LogMaintenanceRequest, v1.LogMaintenanceResponse]
+	maintenanceHistory *connect.Client[v1.MaintenanceHistoryRequest, v1.MaintenanceHistoryResponse]
+	dueMaintenance     *connect.Client[v1.DueMaintenanceRequest, v1.DueMaintenanceResponse]
+	checkOut           *connect.Client[v1.CheckOutRequest, v1.CheckOutResponse]
+	checkIn            *connect.Client[v1.CheckInRequest, v1.CheckInResponse]
+	listCheckedOut     *connect.Client[v1.ListCheckedOutRequest, v1.ListCheckedOutResponse]
+	move               *connect.Client[v1.MoveRequest, v1.MoveResponse]
+	delete             *connect.Client[v1.DeleteRequest, v1.DeleteResponse]
+	listTrash          *connect.Client[v1.ListTrashRequest, v1.ListTrashResponse]
+	restore            *connect.Client[v1.RestoreRequest, v1.RestoreResponse]
+	emptyTrash         *connect.Client[v1.EmptyTrashRequest, v1.EmptyTrashResponse]
+	search             *connect.Client[v1.SearchRequest, v1.SearchResponse]
+	history            *connect.Client[v1.HistoryRequest, v1.HistoryResponse]
+	undo               *connect.Client[v1.UndoRequest, v1.UndoResponse]
+	redo               *connect.Client[v1.RedoRequest, v1.RedoResponse]
+	listRevisions      *connect.Client[v1.ListRevisionsRequest, v1.ListRevisionsResponse]
+	readAt             *connect.Client[v1.ReadAtRequest, v1.ReadAtResponse]
+	startAudit         *connect.Client[v1.StartAuditRequest, v1.StartAuditResponse]
+	markSeen           *connect.Client[v1.MarkSeenRequest, v1.MarkSeenResponse]
+	finishAudit        *connect.Client[v1.FinishAuditRequest, v1.FinishAuditResponse]
+	watch              *connect.Client[v1.WatchRequest, v1.WatchResponse]
 }
 
 // Read calls v1.ArchiveService.Read.
@@ -121,11 +491,111 @@ func (c *archiveServiceClient) Read(ctx context.Context, req *connect.Request[v1
 	return c.read.CallUnary(ctx, req)
 }
 
+// ResolveID calls v1.ArchiveService.ResolveID.
+func (c *archiveServiceClient) ResolveID(ctx context.Context, req *connect.Request[v1.ResolveIDRequest]) (*connect.Response[v1.ResolveIDResponse], error) {
+	return c.resolveID.CallUnary(ctx, req)
+}
+
+// Locate calls v1.ArchiveService.Locate.
+func (c *archiveServiceClient) Locate(ctx context.Context, req *connect.Request[v1.LocateRequest]) (*connect.Response[v1.LocateResponse], error) {
+	return c.locate.CallUnary(ctx, req)
+}
+
 // Create calls v1.ArchiveService.Create.
 func (c *archiveServiceClient) Create(ctx context.Context, req *connect.Request[v1.CreateRequest]) (*connect.Response[v1.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
+// Update calls v1.ArchiveService.Update.
+func (c *archiveServiceClient) Update(ctx context.Context, req *connect.Request[v1.UpdateRequest]) (*connect.Response[v1.UpdateResponse], error) {
+	return c.update.CallUnary(ctx, req)
+}
+
+// AddImage calls v1.ArchiveService.AddImage.
+func (c *archiveServiceClient) AddImage(ctx context.Context, req *connect.Request[v1.AddImageRequest]) (*connect.Response[v1.AddImageResponse], error) {
+	return c.addImage.CallUnary(ctx, req)
+}
+
+// ReorderImages calls v1.ArchiveService.ReorderImages.
+func (c *archiveServiceClient) ReorderImages(ctx context.Context, req *connect.Request[v1.ReorderImagesRequest]) (*connect.Response[v1.ReorderImagesResponse], error) {
+	return c.reorderImages.CallUnary(ctx, req)
+}
+
+// SetPrimaryImage calls v1.ArchiveService.SetPrimaryImage.
+func (c *archiveServiceClient) SetPrimaryImage(ctx context.Context, req *connect.Request[v1.SetPrimaryImageRequest]) (*connect.Response[v1.SetPrimaryImageResponse], error) {
+	return c.setPrimaryImage.CallUnary(ctx, req)
+}
+
+// RemoveImage calls v1.ArchiveService.RemoveImage.
+func (c *archiveServiceClient) RemoveImage(ctx context.Context, req *connect.Request[v1.RemoveImageRequest]) (*connect.Response[v1.RemoveImageResponse], error) {
+	return c.removeImage.CallUnary(ctx, req)
+}
+
+// ListAttachments calls v1.ArchiveService.ListAttachments.
+func (c *archiveServiceClient) ListAttachments(ctx context.Context, req *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error) {
+	return c.listAttachments.CallUnary(ctx, req)
+}
+
+// UploadAttachment calls v1.ArchiveService.UploadAttachment.
+func (c *archiveServiceClient) UploadAttachment(ctx context.Context) *connect.ClientStreamForClient[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse] {
+	return c.uploadAttachment.CallClientStream(ctx)
+}
+
+// DownloadAttachment calls v1.ArchiveService.DownloadAttachment.
+func (c *archiveServiceClient) DownloadAttachment(ctx context.Context, req *connect.Request[v1.DownloadAttachmentRequest]) (*connect.ServerStreamForClient[v1.DownloadAttachmentResponse], error) {
+	return c.downloadAttachment.CallServerStream(ctx, req)
+}
+
+// AdjustQuantity calls v1.ArchiveService.AdjustQuantity.
+func (c *archiveServiceClient) AdjustQuantity(ctx context.Context, req *connect.Request[v1.AdjustQuantityRequest]) (*connect.Response[v1.AdjustQuantityResponse], error) {
+	return c.adjustQuantity.CallUnary(ctx, req)
+}
+
+// QuantityHistory calls v1.ArchiveService.QuantityHistory.
+func (c *archiveServiceClient) QuantityHistory(ctx context.Context, req *connect.Request[v1.QuantityHistoryRequest]) (*connect.Response[v1.QuantityHistoryResponse], error) {
+	return c.quantityHistory.CallUnary(ctx, req)
+}
+
+// LowStock calls v1.ArchiveService.LowStock.
+func (c *archiveServiceClient) LowStock(ctx context.Context, req *connect.Request[v1.LowStockRequest]) (*connect.Response[v1.LowStockResponse], error) {
+	return c.lowStock.CallUnary(ctx, req)
+}
+
+// Upcoming calls v1.ArchiveService.Upcoming.
+func (c *archiveServiceClient) Upcoming(ctx context.Context, req *connect.Request[v1.UpcomingRequest]) (*connect.Response[v1.UpcomingResponse], error) {
+	return c.upcoming.CallUnary(ctx, req)
+}
+
+// LogMaintenance calls v1.ArchiveService.LogMaintenance.
+func (c *archiveServiceClient) LogMaintenance(ctx context.Context, req *connect.Request[v1.LogMaintenanceRequest]) (*connect.Response[v1.LogMaintenanceResponse], error) {
+	return c.logMaintenance.CallUnary(ctx, req)
+}
+
+// MaintenanceHistory calls v1.ArchiveService.MaintenanceHistory.
+func (c *archiveServiceClient) MaintenanceHistory(ctx context.Context, req *connect.Request[v1.MaintenanceHistoryRequest]) (*connect.Response[v1.MaintenanceHistoryResponse], error) {
+	return c.maintenanceHistory.CallUnary(ctx, req)
+}
+
+// DueMaintenance calls v1.ArchiveService.DueMaintenance.
+func (c *archiveServiceClient) DueMaintenance(ctx context.Context, req *connect.Request[v1.DueMaintenanceRequest]) (*connect.Response[v1.DueMaintenanceResponse], error) {
+	return c.dueMaintenance.CallUnary(ctx, req)
+}
+
+// CheckOut calls v1.ArchiveService.CheckOut.
+func (c *archiveServiceClient) CheckOut(ctx context.Context, req *connect.Request[v1.CheckOutRequest]) (*connect.Response[v1.CheckOutResponse], error) {
+	return c.checkOut.CallUnary(ctx, req)
+}
+
+// CheckIn calls v1.ArchiveService.CheckIn.
+func (c *archiveServiceClient) CheckIn(ctx context.Context, req *connect.Request[v1.CheckInRequest]) (*connect.Response[v1.CheckInResponse], error) {
+	return c.checkIn.CallUnary(ctx, req)
+}
+
+// ListCheckedOut calls v1.ArchiveService.ListCheckedOut.
+func (c *archiveServiceClient) ListCheckedOut(ctx context.Context, req *connect.Request[v1.ListCheckedOutRequest]) (*connect.Response[v1.ListCheckedOutResponse], error) {
+	return c.listCheckedOut.CallUnary(ctx, req)
+}
+
 // Move calls v1.ArchiveService.Move.
 func (c *archiveServiceClient) Move(ctx context.Context, req *connect.Request[v1.MoveRequest]) (*connect.Response[v1.MoveResponse], error) {
 	return c.move.CallUnary(ctx, req)
@@ -136,18 +606,110 @@ func (c *archiveServiceClient) Delete(ctx context.Context, req *connect.Request[
 	return c.delete.CallUnary(ctx, req)
 }
 
+// ListTrash calls v1.ArchiveService.ListTrash.
+func (c *archiveServiceClient) ListTrash(ctx context.Context, req *connect.Request[v1.ListTrashRequest]) (*connect.Response[v1.ListTrashResponse], error) {
+	return c.listTrash.CallUnary(ctx, req)
+}
+
+// Restore calls v1.ArchiveService.Restore.
+func (c *archiveServiceClient) Restore(ctx context.Context, req *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.RestoreResponse], error) {
+	return c.restore.CallUnary(ctx, req)
+}
+
+// EmptyTrash calls v1.ArchiveService.EmptyTrash.
+func (c *archiveServiceClient) EmptyTrash(ctx context.Context, req *connect.Request[v1.EmptyTrashRequest]) (*connect.Response[v1.EmptyTrashResponse], error) {
+	return c.emptyTrash.CallUnary(ctx, req)
+}
+
 // Search calls v1.ArchiveService.Search.
 func (c *archiveServiceClient) Search(ctx context.Context, req *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
 	return c.search.CallUnary(ctx, req)
 }
 
+// History calls v1.ArchiveService.History.
+func (c *archiveServiceClient) History(ctx context.Context, req *connect.Request[v1.HistoryRequest]) (*connect.Response[v1.HistoryResponse], error) {
+	return c.history.CallUnary(ctx, req)
+}
+
+// Undo calls v1.ArchiveService.Undo.
+func (c *archiveServiceClient) Undo(ctx context.Context, req *connect.Request[v1.UndoRequest]) (*connect.Response[v1.UndoResponse], error) {
+	return c.undo.CallUnary(ctx, req)
+}
+
+// Redo calls v1.ArchiveService.Redo.
+func (c *archiveServiceClient) Redo(ctx context.Context, req *connect.Request[v1.RedoRequest]) (*connect.Response[v1.RedoResponse], error) {
+	return c.redo.CallUnary(ctx, req)
+}
+
+// ListRevisions calls v1.ArchiveService.ListRevisions.
+func (c *archiveServiceClient) ListRevisions(ctx context.Context, req *connect.Request[v1.ListRevisionsRequest]) (*connect.Response[v1.ListRevisionsResponse], error) {
+	return c.listRevisions.CallUnary(ctx, req)
+}
+
+// ReadAt calls v1.ArchiveService.ReadAt.
+func (c *archiveServiceClient) ReadAt(ctx context.Context, req *connect.Request[v1.ReadAtRequest]) (*connect.Response[v1.ReadAtResponse], error) {
+	return c.readAt.CallUnary(ctx, req)
+}
+
+// StartAudit calls v1.ArchiveService.StartAudit.
+func (c *archiveServiceClient) StartAudit(ctx context.Context, req *connect.Request[v1.StartAuditRequest]) (*connect.Response[v1.StartAuditResponse], error) {
+	return c.startAudit.CallUnary(ctx, req)
+}
+
+// MarkSeen calls v1.ArchiveService.MarkSeen.
+func (c *archiveServiceClient) MarkSeen(ctx context.Context, req *connect.Request[v1.MarkSeenRequest]) (*connect.Response[v1.MarkSeenResponse], error) {
+	return c.markSeen.CallUnary(ctx, req)
+}
+
+// FinishAudit calls v1.ArchiveService.FinishAudit.
+func (c *archiveServiceClient) FinishAudit(ctx context.Context, req *connect.Request[v1.FinishAuditRequest]) (*connect.Response[v1.FinishAuditResponse], error) {
+	return c.finishAudit.CallUnary(ctx, req)
+}
+
+// Watch calls v1.ArchiveService.Watch.
+func (c *archiveServiceClient) Watch(ctx context.Context, req *connect.Request[v1.WatchRequest]) (*connect.ServerStreamForClient[v1.WatchResponse], error) {
+	return c.watch.CallServerStream(ctx, req)
+}
+
 // ArchiveServiceHandler is an implementation of the v1.ArchiveService service.
 type ArchiveServiceHandler interface {
 	Read(context.Context, *connect.Request[v1.ReadRequest]) (*connect.Response[v1.ReadResponse], error)
+	ResolveID(context.Context, *connect.Request[v1.ResolveIDRequest]) (*connect.Response[v1.ResolveIDResponse], error)
+	Locate(context.Context, *connect.Request[v1.LocateRequest]) (*connect.Response[v1.LocateResponse], error)
 	Create(context.Context, *connect.Request[v1.CreateRequest]) (*connect.Response[v1.CreateResponse], error)
+	Update(context.Context, *connect.Request[v1.UpdateRequest]) (*connect.Response[v1.UpdateResponse], error)
+	AddImage(context.Context, *connect.Request[v1.AddImageRequest]) (*connect.Response[v1.AddImageResponse], error)
+	ReorderImages(context.Context, *connect.Request[v1.ReorderImagesRequest]) (*connect.Response[v1.ReorderImagesResponse], error)
+	SetPrimaryImage(context.Context, *connect.Request[v1.SetPrimaryImageRequest]) (*connect.Response[v1.SetPrimaryImageResponse], error)
+	RemoveImage(context.Context, *connect.Request[v1.RemoveImageRequest]) (*connect.Response[v1.RemoveImageResponse], error)
+	ListAttachments(context.Context, *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error)
+	UploadAttachment(context.Context, *connect.ClientStream[v1.UploadAttachmentRequest]) (*connect.Response[v1.UploadAttachmentResponse], error)
+	DownloadAttachment(context.Context, *connect.Request[v1.DownloadAttachmentRequest], *connect.ServerStream[v1.DownloadAttachmentResponse]) error
+	AdjustQuantity(context.Context, *connect.Request[v1.AdjustQuantityRequest]) (*connect.Response[v1.AdjustQuantityResponse], error)
+	QuantityHistory(context.Context, *connect.Request[v1.QuantityHistoryRequest]) (*connect.Response[v1.QuantityHistoryResponse], error)
+	LowStock(context.Context, *connect.Request[v1.LowStockRequest]) (*connect.Response[v1.LowStockResponse], error)
+	Upcoming(context.Context, *connect.Request[v1.UpcomingRequest]) (*connect.Response[v1.UpcomingResponse], error)
+	LogMaintenance(context.Context, *connect.Request[v1.LogMaintenanceRequest]) (*connect.Response[v1.LogMaintenanceResponse], error)
+	MaintenanceHistory(context.Context, *connect.Request[v1.MaintenanceHistoryRequest]) (*connect.Response[v1.MaintenanceHistoryResponse], error)
+	DueMaintenance(context.Context, *connect.Request[v1.DueMaintenanceRequest]) (*connect.Response[v1.DueMaintenanceResponse], error)
+	CheckOut(context.Context, *connect.Request[v1.CheckOutRequest]) (*connect.Response[v1.CheckOutResponse], error)
+	CheckIn(context.Context, *connect.Request[v1.CheckInRequest]) (*connect.Response[v1.CheckInResponse], error)
+	ListCheckedOut(context.Context, *connect.Request[v1.ListCheckedOutRequest]) (*connect.Response[v1.ListCheckedOutResponse], error)
 	Move(context.Context, *connect.Request[v1.MoveRequest]) (*connect.Response[v1.MoveResponse], error)
 	Delete(context.Context, *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error)
+	ListTrash(context.Context, *connect.Request[v1.ListTrashRequest]) (*connect.Response[v1.ListTrashResponse], error)
+	Restore(context.Context, *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.RestoreResponse], error)
+	EmptyTrash(context.Context, *connect.Request[v1.EmptyTrashRequest]) (*connect.Response[v1.EmptyTrashResponse], error)
 	Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error)
+	History(context.Context, *connect.Request[v1.HistoryRequest]) (*connect.Response[v1.HistoryResponse], error)
+	Undo(context.Context, *connect.Request[v1.UndoRequest]) (*connect.Response[v1.UndoResponse], error)
+	Redo(context.Context, *connect.Request[v1.RedoRequest]) (*connect.Response[v1.RedoResponse], error)
+	ListRevisions(context.Context, *connect.Request[v1.ListRevisionsRequest]) (*connect.Response[v1.ListRevisionsResponse], error)
+	ReadAt(context.Context, *connect.Request[v1.ReadAtRequest]) (*connect.Response[v1.ReadAtResponse], error)
+	StartAudit(context.Context, *connect.Request[v1.StartAuditRequest]) (*connect.Response[v1.StartAuditResponse], error)
+	MarkSeen(context.Context, *connect.Request[v1.MarkSeenRequest]) (*connect.Response[v1.MarkSeenResponse], error)
+	FinishAudit(context.Context, *connect.Request[v1.FinishAuditRequest]) (*connect.Response[v1.FinishAuditResponse], error)
+	Watch(context.Context, *connect.Request[v1.WatchRequest], *connect.ServerStream[v1.WatchResponse]) error
 }
 
 // NewArchiveServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -162,12 +724,132 @@ func NewArchiveServiceHandler(svc ArchiveServiceHandler, opts ...connect.Handler
 		connect.WithSchema(archiveServiceReadMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	archiveServiceResolveIDHandler := connect.NewUnaryHandler(
+		ArchiveServiceResolveIDProcedure,
+		svc.ResolveID,
+		connect.WithSchema(archiveServiceResolveIDMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceLocateHandler := connect.NewUnaryHandler(
+		ArchiveServiceLocateProcedure,
+		svc.Locate,
+		connect.WithSchema(archiveServiceLocateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	archiveServiceCreateHandler := connect.NewUnaryHandler(
 		ArchiveServiceCreateProcedure,
 		svc.Create,
 		connect.WithSchema(archiveServiceCreateMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	archiveServiceUpdateHandler := connect.NewUnaryHandler(
+		ArchiveServiceUpdateProcedure,
+		svc.Update,
+		connect.WithSchema(archiveServiceUpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceAddImageHandler := connect.NewUnaryHandler(
+		ArchiveServiceAddImageProcedure,
+		svc.AddImage,
+		connect.WithSchema(archiveServiceAddImageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceReorderImagesHandler := connect.NewUnaryHandler(
+		ArchiveServiceReorderImagesProcedure,
+		svc.ReorderImages,
+		connect.WithSchema(archiveServiceReorderImagesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceSetPrimaryImageHandler := connect.NewUnaryHandler(
+		ArchiveServiceSetPrimaryImageProcedure,
+		svc.SetPrimaryImage,
+		connect.WithSchema(archiveServiceSetPrimaryImageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceRemoveImageHandler := connect.NewUnaryHandler(
+		ArchiveServiceRemoveImageProcedure,
+		svc.RemoveImage,
+		connect.WithSchema(archiveServiceRemoveImageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceListAttachmentsHandler := connect.NewUnaryHandler(
+		ArchiveServiceListAttachmentsProcedure,
+		svc.ListAttachments,
+		connect.WithSchema(archiveServiceListAttachmentsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceUploadAttachmentHandler := connect.NewClientStreamHandler(
+		ArchiveServiceUploadAttachmentProcedure,
+		svc.UploadAttachment,
+		connect.WithSchema(archiveServiceUploadAttachmentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceDownloadAttachmentHandler := connect.NewServerStreamHandler(
+		ArchiveServiceDownloadAttachmentProcedure,
+		svc.DownloadAttachment,
+		connect.WithSchema(archiveServiceDownloadAttachmentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceAdjustQuantityHandler := connect.NewUnaryHandler(
+		ArchiveServiceAdjustQuantityProcedure,
+		svc.AdjustQuantity,
+		connect.WithSchema(archiveServiceAdjustQuantityMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceQuantityHistoryHandler := connect.NewUnaryHandler(
+		ArchiveServiceQuantityHistoryProcedure,
+		svc.QuantityHistory,
+		connect.WithSchema(archiveServiceQuantityHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceLowStockHandler := connect.NewUnaryHandler(
+		ArchiveServiceLowStockProcedure,
+		svc.LowStock,
+		connect.WithSchema(archiveServiceLowStockMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceUpcomingHandler := connect.NewUnaryHandler(
+		ArchiveServiceUpcomingProcedure,
+		svc.Upcoming,
+		connect.WithSchema(archiveServiceUpcomingMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceLogMaintenanceHandler := connect.NewUnaryHandler(
+		ArchiveServiceLogMaintenanceProcedure,
+		svc.LogMaintenance,
+		connect.WithSchema(archiveServiceLogMaintenanceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceMaintenanceHistoryHandler := connect.NewUnaryHandler(
+		ArchiveServiceMaintenanceHistoryProcedure,
+		svc.MaintenanceHistory,
+		connect.WithSchema(archiveServiceMaintenanceHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceDueMaintenanceHandler := connect.NewUnaryHandler(
+		ArchiveServiceDueMaintenanceProcedure,
+		svc.DueMaintenance,
+		connect.WithSchema(archiveServiceDueMaintenanceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceCheckOutHandler := connect.NewUnaryHandler(
+		ArchiveServiceCheckOutProcedure,
+		svc.CheckOut,
+		connect.WithSchema(archiveServiceCheckOutMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceCheckInHandler := connect.NewUnaryHandler(
+		ArchiveServiceCheckInProcedure,
+		svc.CheckIn,
+		connect.WithSchema(archiveServiceCheckInMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceListCheckedOutHandler := connect.NewUnaryHandler(
+		ArchiveServiceListCheckedOutProcedure,
+		svc.ListCheckedOut,
+		connect.WithSchema(archiveServiceListCheckedOutMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	archiveServiceMoveHandler := connect.NewUnaryHandler(
 		ArchiveServiceMoveProcedure,
 		svc.Move,
@@ -180,24 +862,160 @@ func NewArchiveServiceHandler(svc ArchiveServiceHandler, opts ...connect.Handler
 		connect.WithSchema(archiveServiceDeleteMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	archiveServiceListTrashHandler := connect.NewUnaryHandler(
+		ArchiveServiceListTrashProcedure,
+		svc.ListTrash,
+		connect.WithSchema(archiveServiceListTrashMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceRestoreHandler := connect.NewUnaryHandler(
+		ArchiveServiceRestoreProcedure,
+		svc.Restore,
+		connect.WithSchema(archiveServiceRestoreMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceEmptyTrashHandler := connect.NewUnaryHandler(
+		ArchiveServiceEmptyTrashProcedure,
+		svc.EmptyTrash,
+		connect.WithSchema(archiveServiceEmptyTrashMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	archiveServiceSearchHandler := connect.NewUnaryHandler(
 		ArchiveServiceSearchProcedure,
 		svc.Search,
 		connect.WithSchema(archiveServiceSearchMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	archiveServiceHistoryHandler := connect.NewUnaryHandler(
+		ArchiveServiceHistoryProcedure,
+		svc.History,
+		connect.WithSchema(archiveServiceHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceUndoHandler := connect.NewUnaryHandler(
+		ArchiveServiceUndoProcedure,
+		svc.Undo,
+		connect.WithSchema(archiveServiceUndoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceRedoHandler := connect.NewUnaryHandler(
+		ArchiveServiceRedoProcedure,
+		svc.Redo,
+		connect.WithSchema(archiveServiceRedoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceListRevisionsHandler := connect.NewUnaryHandler(
+		ArchiveServiceListRevisionsProcedure,
+		svc.ListRevisions,
+		connect.WithSchema(archiveServiceListRevisionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceReadAtHandler := connect.NewUnaryHandler(
+		ArchiveServiceReadAtProcedure,
+		svc.ReadAt,
+		connect.WithSchema(archiveServiceReadAtMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceStartAuditHandler := connect.NewUnaryHandler(
+		ArchiveServiceStartAuditProcedure,
+		svc.StartAudit,
+		connect.WithSchema(archiveServiceStartAuditMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceMarkSeenHandler := connect.NewUnaryHandler(
+		ArchiveServiceMarkSeenProcedure,
+		svc.MarkSeen,
+		connect.WithSchema(archiveServiceMarkSeenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceFinishAuditHandler := connect.NewUnaryHandler(
+		ArchiveServiceFinishAuditProcedure,
+		svc.FinishAudit,
+		connect.WithSchema(archiveServiceFinishAuditMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	archiveServiceWatchHandler := connect.NewServerStreamHandler(
+		ArchiveServiceWatchProcedure,
+		svc.Watch,
+		connect.WithSchema(archiveServiceWatchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/v1.ArchiveService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ArchiveServiceReadProcedure:
 			archiveServiceReadHandler.ServeHTTP(w, r)
+		case ArchiveServiceResolveIDProcedure:
+			archiveServiceResolveIDHandler.ServeHTTP(w, r)
+		case ArchiveServiceLocateProcedure:
+			archiveServiceLocateHandler.ServeHTTP(w, r)
 		case ArchiveServiceCreateProcedure:
 			archiveServiceCreateHandler.ServeHTTP(w, r)
+		case ArchiveServiceUpdateProcedure:
+			archiveServiceUpdateHandler.ServeHTTP(w, r)
+		case ArchiveServiceAddImageProcedure:
+			archiveServiceAddImageHandler.ServeHTTP(w, r)
+		case ArchiveServiceReorderImagesProcedure:
+			archiveServiceReorderImagesHandler.ServeHTTP(w, r)
+		case ArchiveServiceSetPrimaryImageProcedure:
+			archiveServiceSetPrimaryImageHandler.ServeHTTP(w, r)
+		case ArchiveServiceRemoveImageProcedure:
+			archiveServiceRemoveImageHandler.ServeHTTP(w, r)
+		case ArchiveServiceListAttachmentsProcedure:
+			archiveServiceListAttachmentsHandler.ServeHTTP(w, r)
+		case ArchiveServiceUploadAttachmentProcedure:
+			archiveServiceUploadAttachmentHandler.ServeHTTP(w, r)
+		case ArchiveServiceDownloadAttachmentProcedure:
+			archiveServiceDownloadAttachmentHandler.ServeHTTP(w, r)
+		case ArchiveServiceAdjustQuantityProcedure:
+			archiveServiceAdjustQuantityHandler.ServeHTTP(w, r)
+		case ArchiveServiceQuantityHistoryProcedure:
+			archiveServiceQuantityHistoryHandler.ServeHTTP(w, r)
+		case ArchiveServiceLowStockProcedure:
+			archiveServiceLowStockHandler.ServeHTTP(w, r)
+		case ArchiveServiceUpcomingProcedure:
+			archiveServiceUpcomingHandler.ServeHTTP(w, r)
+		case ArchiveServiceLogMaintenanceProcedure:
+			archiveServiceLogMaintenanceHandler.ServeHTTP(w, r)
+		case ArchiveServiceMaintenanceHistoryProcedure:
+			archiveServiceMaintenanceHistoryHandler.ServeHTTP(w, r)
+		case ArchiveServiceDueMaintenanceProcedure:
+			archiveServiceDueMaintenanceHandler.ServeHTTP(w, r)
+		case ArchiveServiceCheckOutProcedure:
+			archiveServiceCheckOutHandler.ServeHTTP(w, r)
+		case ArchiveServiceCheckInProcedure:
+			archiveServiceCheckInHandler.ServeHTTP(w, r)
+		case ArchiveServiceListCheckedOutProcedure:
+			archiveServiceListCheckedOutHandler.ServeHTTP(w, r)
 		case ArchiveServiceMoveProcedure:
 			archiveServiceMoveHandler.ServeHTTP(w, r)
 		case ArchiveServiceDeleteProcedure:
 			archiveServiceDeleteHandler.ServeHTTP(w, r)
+		case ArchiveServiceListTrashProcedure:
+			archiveServiceListTrashHandler.ServeHTTP(w, r)
+		case ArchiveServiceRestoreProcedure:
+			archiveServiceRestoreHandler.ServeHTTP(w, r)
+		case ArchiveServiceEmptyTrashProcedure:
+			archiveServiceEmptyTrashHandler.ServeHTTP(w, r)
 		case ArchiveServiceSearchProcedure:
 			archiveServiceSearchHandler.ServeHTTP(w, r)
+		case ArchiveServiceHistoryProcedure:
+			archiveServiceHistoryHandler.ServeHTTP(w, r)
+		case ArchiveServiceUndoProcedure:
+			archiveServiceUndoHandler.ServeHTTP(w, r)
+		case ArchiveServiceRedoProcedure:
+			archiveServiceRedoHandler.ServeHTTP(w, r)
+		case ArchiveServiceListRevisionsProcedure:
+			archiveServiceListRevisionsHandler.ServeHTTP(w, r)
+		case ArchiveServiceReadAtProcedure:
+			archiveServiceReadAtHandler.ServeHTTP(w, r)
+		case ArchiveServiceStartAuditProcedure:
+			archiveServiceStartAuditHandler.ServeHTTP(w, r)
+		case ArchiveServiceMarkSeenProcedure:
+			archiveServiceMarkSeenHandler.ServeHTTP(w, r)
+		case ArchiveServiceFinishAuditProcedure:
+			archiveServiceFinishAuditHandler.ServeHTTP(w, r)
+		case ArchiveServiceWatchProcedure:
+			archiveServiceWatchHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -211,10 +1029,90 @@ func (UnimplementedArchiveServiceHandler) Read(context.Context, *connect.Request
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.Read is not implemented"))
 }
 
+func (UnimplementedArchiveServiceHandler) ResolveID(context.Context, *connect.Request[v1.ResolveIDRequest]) (*connect.Response[v1.ResolveIDResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.ResolveID is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) Locate(context.Context, *connect.Request[v1.LocateRequest]) (*connect.Response[v1.LocateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.Locate is not implemented"))
+}
+
 func (UnimplementedArchiveServiceHandler) Create(context.Context, *connect.Request[v1.CreateRequest]) (*connect.Response[v1.CreateResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.Create is not implemented"))
 }
 
+func (UnimplementedArchiveServiceHandler) Update(context.Context, *connect.Request[v1.UpdateRequest]) (*connect.Response[v1.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.Update is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) AddImage(context.Context, *connect.Request[v1.AddImageRequest]) (*connect.Response[v1.AddImageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.AddImage is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) ReorderImages(context.Context, *connect.Request[v1.ReorderImagesRequest]) (*connect.Response[v1.ReorderImagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.ReorderImages is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) SetPrimaryImage(context.Context, *connect.Request[v1.SetPrimaryImageRequest]) (*connect.Response[v1.SetPrimaryImageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.SetPrimaryImage is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) RemoveImage(context.Context, *connect.Request[v1.RemoveImageRequest]) (*connect.Response[v1.RemoveImageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.RemoveImage is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) ListAttachments(context.Context, *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.ListAttachments is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) UploadAttachment(context.Context, *connect.ClientStream[v1.UploadAttachmentRequest]) (*connect.Response[v1.UploadAttachmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.UploadAttachment is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) DownloadAttachment(context.Context, *connect.Request[v1.DownloadAttachmentRequest], *connect.ServerStream[v1.DownloadAttachmentResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.DownloadAttachment is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) AdjustQuantity(context.Context, *connect.Request[v1.AdjustQuantityRequest]) (*connect.Response[v1.AdjustQuantityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.AdjustQuantity is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) QuantityHistory(context.Context, *connect.Request[v1.QuantityHistoryRequest]) (*connect.Response[v1.QuantityHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.QuantityHistory is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) LowStock(context.Context, *connect.Request[v1.LowStockRequest]) (*connect.Response[v1.LowStockResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.LowStock is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) Upcoming(context.Context, *connect.Request[v1.UpcomingRequest]) (*connect.Response[v1.UpcomingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.Upcoming is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) LogMaintenance(context.Context, *connect.Request[v1.LogMaintenanceRequest]) (*connect.Response[v1.LogMaintenanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.LogMaintenance is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) MaintenanceHistory(context.Context, *connect.Request[v1.MaintenanceHistoryRequest]) (*connect.Response[v1.MaintenanceHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.MaintenanceHistory is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) DueMaintenance(context.Context, *connect.Request[v1.DueMaintenanceRequest]) (*connect.Response[v1.DueMaintenanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.DueMaintenance is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) CheckOut(context.Context, *connect.Request[v1.CheckOutRequest]) (*connect.Response[v1.CheckOutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.CheckOut is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) CheckIn(context.Context, *connect.Request[v1.CheckInRequest]) (*connect.Response[v1.CheckInResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.CheckIn is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) ListCheckedOut(context.Context, *connect.Request[v1.ListCheckedOutRequest]) (*connect.Response[v1.ListCheckedOutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.ListCheckedOut is not implemented"))
+}
+
 func (UnimplementedArchiveServiceHandler) Move(context.Context, *connect.Request[v1.MoveRequest]) (*connect.Response[v1.MoveResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.Move is not implemented"))
 }
@@ -223,6 +1121,54 @@ func (UnimplementedArchiveServiceHandler) Delete(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.Delete is not implemented"))
 }
 
+func (UnimplementedArchiveServiceHandler) ListTrash(context.Context, *connect.Request[v1.ListTrashRequest]) (*connect.Response[v1.ListTrashResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.ListTrash is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) Restore(context.Context, *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.RestoreResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.Restore is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) EmptyTrash(context.Context, *connect.Request[v1.EmptyTrashRequest]) (*connect.Response[v1.EmptyTrashResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.EmptyTrash is not implemented"))
+}
+
 func (UnimplementedArchiveServiceHandler) Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.Search is not implemented"))
 }
+
+func (UnimplementedArchiveServiceHandler) History(context.Context, *connect.Request[v1.HistoryRequest]) (*connect.Response[v1.HistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.History is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) Undo(context.Context, *connect.Request[v1.UndoRequest]) (*connect.Response[v1.UndoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.Undo is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) Redo(context.Context, *connect.Request[v1.RedoRequest]) (*connect.Response[v1.RedoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.Redo is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) ListRevisions(context.Context, *connect.Request[v1.ListRevisionsRequest]) (*connect.Response[v1.ListRevisionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.ListRevisions is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) ReadAt(context.Context, *connect.Request[v1.ReadAtRequest]) (*connect.Response[v1.ReadAtResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.ReadAt is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) StartAudit(context.Context, *connect.Request[v1.StartAuditRequest]) (*connect.Response[v1.StartAuditResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.StartAudit is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) MarkSeen(context.Context, *connect.Request[v1.MarkSeenRequest]) (*connect.Response[v1.MarkSeenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.MarkSeen is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) FinishAudit(context.Context, *connect.Request[v1.FinishAuditRequest]) (*connect.Response[v1.FinishAuditResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.FinishAudit is not implemented"))
+}
+
+func (UnimplementedArchiveServiceHandler) Watch(context.Context, *connect.Request[v1.WatchRequest], *connect.ServerStream[v1.WatchResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("v1.ArchiveService.Watch is not implemented"))
+}
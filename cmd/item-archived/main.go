@@ -4,12 +4,16 @@ import (
 	"flag"
 	"fmt"
 	"item-archived/api/v1/v1connect"
+	"item-archived/internal/notifier"
 	"item-archived/internal/service"
 	"log/slog"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
+	"connectrpc.com/connect"
 	connectcors "connectrpc.com/cors"
 	"github.com/lmittmann/tint"
 	"github.com/rs/cors"
@@ -17,6 +21,10 @@ import (
 	"golang.org/x/net/http2/h2c"
 )
 
+// smtpPasswordEnv is the environment variable the password of the mail server
+// is read from, so it does not show up in the process list.
+const smtpPasswordEnv = "ITEM_ARCHIVED_SMTP_PASSWORD"
+
 // //go:embed web/dist/*
 // var web embed.FS
 
@@ -36,8 +44,8 @@ func withCORS(connectHandler http.Handler) http.Handler {
 	c := cors.New(cors.Options{
 		AllowedOrigins: []string{"http://localhost:5173"}, // replace with your domain
 		AllowedMethods: connectcors.AllowedMethods(),
-		AllowedHeaders: connectcors.AllowedHeaders(),
-		ExposedHeaders: connectcors.ExposedHeaders(),
+		AllowedHeaders: append(connectcors.AllowedHeaders(), service.ClientHeader),
+		ExposedHeaders: append(connectcors.ExposedHeaders(), service.PageCountHeader),
 		MaxAge:         7200, // 2 hours in seconds
 	})
 	return c.Handler(connectHandler)
@@ -46,6 +54,20 @@ func withCORS(connectHandler http.Handler) http.Handler {
 func main() {
 	reldir := flag.String("dir", ".", "The item archive directory to serve.")
 	verbose := flag.Bool("v", false, "Enable verbose logging.")
+	trashRetention := flag.Duration("trash-retention", 30*24*time.Hour, "How long deleted entries are kept in the trash, 0 keeps them forever.")
+	maxAttachmentSize := flag.Int64("max-attachment-size", 256<<20, "The size in bytes of the largest attachment that can be uploaded, 0 disables the limit.")
+	versioning := flag.Bool("git", false, "Commit every change to a git repository in the archive directory.")
+	publicURL := flag.String("public-url", "http://localhost:5173", "The address the web interface is reachable at, labels and the calendar feed link to it.")
+	reminderInterval := flag.Duration("reminder-interval", time.Hour, "How often reminders of deadlines, low stock and overdue loans are checked.")
+	reminderDays := flag.Int("reminder-days", 7, "How many days before a deadline it is reminded of, and for how many days after it passed.")
+	notifyLog := flag.Bool("notify-log", false, "Log reminders.")
+	notifyFile := flag.String("notify-file", "", "Append reminders as json lines to this file.")
+	notifyWebhook := flag.String("notify-webhook", "", "POST reminders as json to this url.")
+	notifySMTP := flag.String("notify-smtp", "", "Email reminders through the mail server at this host:port, the password is read from $"+smtpPasswordEnv+".")
+	notifySMTPFrom := flag.String("notify-smtp-from", "", "The sender of reminder emails.")
+	notifySMTPTo := flag.String("notify-smtp-to", "", "The comma separated recipients of reminder emails.")
+	notifySMTPUser := flag.String("notify-smtp-user", "", "The username to authenticate with the mail server, no authentication is used if it is empty.")
+	webhooksFile := flag.String("webhooks", "", "A json file with the webhooks to call when entries change, each with a url and optionally events, path and secret.")
 	flag.Parse()
 
 	logLevel := slog.LevelInfo
@@ -70,15 +92,69 @@ func main() {
 
 	slog.Info("item archive directory", "dir", dir)
 
-	service, err := service.NewService(dir)
+	var notifiers []notifier.Notifier
+	if *notifyLog {
+		notifiers = append(notifiers, notifier.Log{})
+	}
+	if *notifyFile != "" {
+		notifiers = append(notifiers, &notifier.File{Path: *notifyFile})
+	}
+	if *notifyWebhook != "" {
+		notifiers = append(notifiers, notifier.Webhook{URL: *notifyWebhook})
+	}
+	if *notifySMTP != "" {
+		if *notifySMTPFrom == "" || *notifySMTPTo == "" {
+			slog.Error("-notify-smtp-from and -notify-smtp-to are required to send reminders by email")
+			os.Exit(1)
+		}
+		to := strings.FieldsFunc(*notifySMTPTo, func(r rune) bool {
+			return r == ',' || r == ' '
+		})
+		notifiers = append(notifiers, notifier.SMTP{
+			Addr:     *notifySMTP,
+			From:     *notifySMTPFrom,
+			To:       to,
+			Username: *notifySMTPUser,
+			Password: os.Getenv(smtpPasswordEnv),
+		})
+	}
+
+	var webhooks []service.Webhook
+	if *webhooksFile != "" {
+		webhooks, err = service.ReadWebhooks(*webhooksFile)
+		if err != nil {
+			slog.Error("failed to read webhooks", "err", err)
+			os.Exit(1)
+		}
+	}
+
+	archiveService, err := service.NewService(dir, service.Options{
+		TrashRetention:    *trashRetention,
+		MaxAttachmentSize: *maxAttachmentSize,
+		Versioning:        *versioning,
+		PublicURL:         *publicURL,
+		Notifiers:         notifiers,
+		ReminderInterval:  *reminderInterval,
+		ReminderDays:      *reminderDays,
+		Webhooks:          webhooks,
+	})
 	if err != nil {
 		slog.Error("failed to create service", "err", err)
 		os.Exit(1)
 	}
 	mux := http.NewServeMux()
 
-	path, connecthandler := v1connect.NewArchiveServiceHandler(service)
+	path, connecthandler := v1connect.NewArchiveServiceHandler(
+		archiveService,
+		connect.WithInterceptors(archiveService.UIDInterceptor()),
+	)
 	mux.Handle(path, withCORS(connecthandler))
+	mux.Handle(service.ImagePattern, withCORS(http.HandlerFunc(archiveService.ServeImage)))
+	mux.Handle(service.ThumbnailPattern, withCORS(http.HandlerFunc(archiveService.ServeThumbnail)))
+	mux.Handle(service.QRCodePattern, withCORS(http.HandlerFunc(archiveService.ServeQRCode)))
+	mux.Handle(service.LabelPattern, withCORS(http.HandlerFunc(archiveService.ServeLabel)))
+	mux.Handle(service.LabelSheetPattern, withCORS(http.HandlerFunc(archiveService.ServeLabelSheet)))
+	mux.Handle(service.CalendarPattern, withCORS(http.HandlerFunc(archiveService.ServeCalendar)))
 
 	// mux.Handle("/", http.StripPrefix("/", http.FileServer(http.FS(web))))
 
@@ -0,0 +1,241 @@
+// Package notifier delivers notifications, like the reminders sent by the
+// archive service, to email, webhooks, the log or a file.
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Notification is a single message, it is sent as is to webhooks and files.
+type Notification struct {
+	// Rule is the name of the rule that caused the notification.
+	Rule string `json:"rule"`
+	// Key identifies what the notification is about within its rule, the
+	// same notification is not sent twice for the same key.
+	Key     string    `json:"key"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body"`
+	Path    []string  `json:"path,omitempty"`
+	Link    string    `json:"link,omitempty"`
+	Time    time.Time `json:"time"`
+}
+
+// Notifier delivers notifications somewhere. Notifiers can implement
+// fmt.Stringer to name where they deliver to so what they were sent can be
+// told apart from what other notifiers were sent, the name must not contain
+// credentials.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// Log writes notifications to the default slog logger.
+type Log struct{}
+
+func (Log) String() string {
+	return "log"
+}
+
+func (Log) Notify(ctx context.Context, n Notification) error {
+	slog.Info("notification", "rule", n.Rule, "subject", n.Subject, "path", n.Path)
+	return nil
+}
+
+// File appends notifications to a file, one json object per line.
+type File struct {
+	Path string
+	mu   sync.Mutex
+}
+
+func (f *File) String() string {
+	return "file " + f.Path
+}
+
+func (f *File) Notify(ctx context.Context, n Notification) error {
+	line, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("File.Notify: %w", err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.OpenFile(f.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("File.Notify: %w", err)
+	}
+	_, err = file.Write(append(line, '\n'))
+	closeErr := file.Close()
+	if err != nil {
+		return fmt.Errorf("File.Notify: %w", err)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("File.Notify: %w", closeErr)
+	}
+	return nil
+}
+
+// Webhook posts notifications as json to a url, any response other than a
+// 2xx status is an error.
+type Webhook struct {
+	URL string
+	// Client is used to make the requests, http.DefaultClient is used if it
+	// is nil.
+	Client *http.Client
+}
+
+func (w Webhook) String() string {
+	return "webhook " + w.URL
+}
+
+func (w Webhook) Notify(ctx context.Context, n Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("Webhook.Notify: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("Webhook.Notify: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Webhook.Notify: %w", err)
+	}
+	res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("Webhook.Notify: %s responded with %s", w.URL, res.Status)
+	}
+	return nil
+}
+
+// crlf replaces every line ending with CRLF, the replacements are tried in
+// order so an existing CRLF is kept as is.
+var crlf = strings.NewReplacer("\r\n", "\r\n", "\r", "\r\n", "\n", "\r\n")
+
+// SMTP sends notifications as plain text emails.
+type SMTP struct {
+	// Addr is the host:port of the mail server.
+	Addr string
+	From string
+	To   []string
+	// Username and Password are used to authenticate with PLAIN auth if
+	// Username is set, the server must support TLS unless it runs on
+	// localhost.
+	Username string
+	Password string
+}
+
+func (s SMTP) String() string {
+	return fmt.Sprintf("smtp %s to %s", s.Addr, strings.Join(s.To, ", "))
+}
+
+func (s SMTP) Notify(ctx context.Context, n Notification) error {
+	host, _, err := net.SplitHostPort(s.Addr)
+	if err != nil {
+		return fmt.Errorf("SMTP.Notify: %w", err)
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", s.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(s.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", n.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", n.Time.Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	body := n.Body
+	if n.Link != "" {
+		body += "\n\n" + n.Link
+	}
+	// lines must end in CRLF whatever they ended in before, a lone dot is
+	// escaped by the data writer
+	msg.WriteString(crlf.Replace(body))
+	msg.WriteString("\r\n")
+
+	// net/smtp does not support contexts, so the connection gets the deadline
+	// of ctx and is closed when ctx is canceled to not wait on a stalled
+	// server forever
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", s.Addr)
+	if err != nil {
+		return fmt.Errorf("SMTP.Notify: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("SMTP.Notify: %w", err)
+	}
+	defer c.Close()
+	err = s.send(c, host, []byte(msg.String()))
+	if err != nil {
+		return fmt.Errorf("SMTP.Notify: %w", err)
+	}
+	return nil
+}
+
+// send sends msg through c like smtp.SendMail does.
+func (s SMTP) send(c *smtp.Client, host string, msg []byte) error {
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		err := c.StartTLS(&tls.Config{ServerName: host})
+		if err != nil {
+			return fmt.Errorf("SMTP.send: %w", err)
+		}
+	}
+	if s.Username != "" {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("SMTP.send: the server does not support authentication")
+		}
+		err := c.Auth(smtp.PlainAuth("", s.Username, s.Password, host))
+		if err != nil {
+			return fmt.Errorf("SMTP.send: %w", err)
+		}
+	}
+	err := c.Mail(s.From)
+	if err != nil {
+		return fmt.Errorf("SMTP.send: %w", err)
+	}
+	for _, to := range s.To {
+		err = c.Rcpt(to)
+		if err != nil {
+			return fmt.Errorf("SMTP.send: %w", err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP.send: %w", err)
+	}
+	_, err = w.Write(msg)
+	if err != nil {
+		return fmt.Errorf("SMTP.send: %w", err)
+	}
+	err = w.Close()
+	if err != nil {
+		return fmt.Errorf("SMTP.send: %w", err)
+	}
+	err = c.Quit()
+	if err != nil {
+		return fmt.Errorf("SMTP.send: %w", err)
+	}
+	return nil
+}
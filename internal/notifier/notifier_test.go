@@ -0,0 +1,170 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+)
+
+// smtpServer is a minimal SMTP server that accepts a single mail and sends
+// its data on mails, or rejects every recipient if reject is set.
+func smtpServer(t *testing.T, reject bool) (string, <-chan string) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	mails := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		c := textproto.NewConn(conn)
+		c.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := c.ReadLine()
+			if err != nil {
+				return
+			}
+			switch verb, _, _ := strings.Cut(line, " "); strings.ToUpper(verb) {
+			case "EHLO", "HELO":
+				c.PrintfLine("250 localhost")
+			case "RCPT":
+				if reject {
+					c.PrintfLine("550 no such user")
+				} else {
+					c.PrintfLine("250 OK")
+				}
+			case "DATA":
+				c.PrintfLine("354 go ahead")
+				// read the raw lines, textproto.DotReader would normalise
+				// the line endings that are under test
+				var data strings.Builder
+				r := bufio.NewReader(c.R)
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if line == ".\r\n" {
+						break
+					}
+					data.WriteString(line)
+				}
+				c.R = r
+				mails <- data.String()
+				c.PrintfLine("250 OK")
+			case "QUIT":
+				c.PrintfLine("221 bye")
+				return
+			default:
+				c.PrintfLine("250 OK")
+			}
+		}
+	}()
+	return l.Addr().String(), mails
+}
+
+func TestSMTPNotify(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		link string
+		want string
+	}{
+		{"LF", "first\nsecond", "", "first\r\nsecond\r\n"},
+		{"CRLF", "first\r\nsecond", "", "first\r\nsecond\r\n"},
+		{"CR", "first\rsecond", "", "first\r\nsecond\r\n"},
+		{"Link", "body", "https://archive.example/#@abc", "body\r\n\r\nhttps://archive.example/#@abc\r\n"},
+		{"Dot", "first\n.\nlast", "", "first\r\n..\r\nlast\r\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			addr, mails := smtpServer(t, false)
+			s := SMTP{Addr: addr, From: "archive@example.com", To: []string{"a@example.com", "b@example.com"}}
+			err := s.Notify(context.Background(), Notification{
+				Subject: "Drill is running low",
+				Body:    test.body,
+				Link:    test.link,
+				Time:    time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			mail := <-mails
+			header, body, ok := strings.Cut(mail, "\r\n\r\n")
+			if !ok {
+				t.Fatalf("mail has no body: %q", mail)
+			}
+			for _, want := range []string{
+				"From: archive@example.com",
+				"To: a@example.com, b@example.com",
+				"Subject: Drill is running low",
+				"Date: Fri, 14 Mar 2025 12:00:00 +0000",
+			} {
+				if !strings.Contains(header, want+"\r\n") {
+					t.Errorf("header is missing %q: %q", want, header)
+				}
+			}
+			if body != test.want {
+				t.Errorf("body is %q, want %q", body, test.want)
+			}
+		})
+	}
+}
+
+func TestSMTPNotifyRejected(t *testing.T) {
+	addr, _ := smtpServer(t, true)
+	s := SMTP{Addr: addr, From: "archive@example.com", To: []string{"a@example.com"}}
+	err := s.Notify(context.Background(), Notification{Subject: "subject", Body: "body"})
+	if err == nil {
+		t.Fatal("Notify succeeded although the recipient was rejected")
+	}
+}
+
+func TestSMTPNotifyStalled(t *testing.T) {
+	// the server accepts connections but never greets
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { conn.Close() })
+		}
+	}()
+	s := SMTP{Addr: l.Addr().String(), From: "archive@example.com", To: []string{"a@example.com"}}
+
+	deadline, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	canceled, cancelNow := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancelNow)
+	for name, ctx := range map[string]context.Context{"Deadline": deadline, "Canceled": canceled} {
+		t.Run(name, func(t *testing.T) {
+			done := make(chan error, 1)
+			go func() {
+				done <- s.Notify(ctx, Notification{Subject: "subject", Body: "body"})
+			}()
+			select {
+			case err := <-done:
+				if err == nil {
+					t.Fatal("Notify succeeded without a greeting from the server")
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("Notify is still waiting for the server after ctx is done")
+			}
+		})
+	}
+}
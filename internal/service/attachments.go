@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	v1 "item-archived/api/v1"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// attachmentsDir is the directory inside an entry that holds its attachments,
+// it can never be mistaken for a child entry since the names of entries
+// always end in .item or .container.
+const attachmentsDir = "attachments"
+
+// attachmentChunkSize is the size of the chunks DownloadAttachment sends.
+const attachmentChunkSize = 64 * 1024
+
+// validateAttachmentName checks that name can be used as the filename of an
+// attachment, names starting with a dot are reserved for temporary files.
+func validateAttachmentName(name string) error {
+	if name == "" {
+		return fmt.Errorf("attachment name cannot be empty")
+	}
+	if strings.HasPrefix(name, ".") {
+		return fmt.Errorf("attachment name \"%s\" cannot start with '.'", name)
+	}
+	if strings.ContainsAny(name, "/\\\x00") {
+		return fmt.Errorf("attachment name \"%s\" cannot contain '/', '\\' or NUL characters", name)
+	}
+	return nil
+}
+
+// detectMimeType guesses the mime type of the file at fpath from its
+// extension, falling back to sniffing its contents.
+func detectMimeType(fpath string) string {
+	mimeType := mime.TypeByExtension(filepath.Ext(fpath))
+	if mimeType != "" {
+		return mimeType
+	}
+	f, err := os.Open(fpath)
+	if err != nil {
+		return "application/octet-stream"
+	}
+	defer f.Close()
+	// DetectContentType never looks at more than 512 bytes
+	buf := make([]byte, 512)
+	n, _ := io.ReadFull(f, buf)
+	return http.DetectContentType(buf[:n])
+}
+
+func readAttachment(fpath string, info os.FileInfo) *v1.Attachment {
+	return &v1.Attachment{
+		Name:       info.Name(),
+		MimeType:   detectMimeType(fpath),
+		Size:       info.Size(),
+		ModifiedAt: timestamppb.New(info.ModTime()),
+	}
+}
+
+// listAttachments returns the attachments of the entry at fpath sorted by name.
+func listAttachments(fpath string) ([]*v1.Attachment, error) {
+	dir := filepath.Join(fpath, attachmentsDir)
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listAttachments: %w", err)
+	}
+	var attachments []*v1.Attachment
+	for _, e := range entries {
+		if !e.Type().IsRegular() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		info, err := e.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listAttachments: %w", err)
+		}
+		attachments = append(attachments, readAttachment(filepath.Join(dir, e.Name()), info))
+	}
+	return attachments, nil
+}
+
+func attachmentNotFound(op string, path []string, name string) error {
+	return entryError(
+		connect.CodeNotFound,
+		v1.EntryError_ATTACHMENT_NOT_FOUND,
+		path,
+		fmt.Errorf("%s: the entry does not have the attachment '%s'", op, name),
+	)
+}
+
+func attachmentExists(op string, path []string, name string) error {
+	return entryError(
+		connect.CodeAlreadyExists,
+		v1.EntryError_ATTACHMENT_ALREADY_EXISTS,
+		path,
+		fmt.Errorf("%s: the entry already has the attachment '%s'", op, name),
+	)
+}
+
+// resolveAttachment resolves the entry at path and validates the name of one
+// of its attachments, it returns the location of the entry and the location
+// of the attachment on disk. Like resolve it guarantees that the attachment
+// is inside the archive, even if it or the attachments directory is a symlink.
+func (s Service) resolveAttachment(path []string, name string) (fpath string, target string, err error) {
+	err = validateAttachmentName(name)
+	if err != nil {
+		return "", "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	fpath, err = s.resolve(path)
+	if err != nil {
+		return "", "", err
+	}
+	_, err = os.Stat(fpath)
+	if err != nil {
+		return "", "", fsError(path, err)
+	}
+
+	target = filepath.Join(fpath, attachmentsDir, name)
+	err = s.checkAttachmentLinks(path, target, filepath.Dir(target))
+	if err != nil {
+		return "", "", err
+	}
+	return fpath, target, nil
+}
+
+// checkAttachmentLinks makes sure none of the given files lead outside of the
+// archive if they are symlinks, files that do not exist are skipped.
+func (s Service) checkAttachmentLinks(path []string, fpaths ...string) error {
+	for _, p := range fpaths {
+		resolved, err := filepath.EvalSymlinks(p)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return fsError(path, err)
+		}
+		if !isWithinDir(s.realDir, resolved) {
+			return entryError(
+				connect.CodePermissionDenied,
+				v1.EntryError_PERMISSION_DENIED,
+				path,
+				fmt.Errorf("checkAttachmentLinks: '%s' leads outside of the archive", filepath.Base(p)),
+			)
+		}
+	}
+	return nil
+}
+
+func (s Service) ListAttachments(ctx context.Context, req *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error) {
+	path := req.Msg.GetPath()
+	fpath, err := s.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	_, err = os.Stat(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	err = s.checkAttachmentLinks(path, filepath.Join(fpath, attachmentsDir))
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := listAttachments(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	return &connect.Response[v1.ListAttachmentsResponse]{
+		Msg: &v1.ListAttachmentsResponse{
+			Attachments: attachments,
+		},
+	}, nil
+}
+
+func (s Service) UploadAttachment(ctx context.Context, stream *connect.ClientStream[v1.UploadAttachmentRequest]) (*connect.Response[v1.UploadAttachmentResponse], error) {
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("UploadAttachment: stream is empty"))
+	}
+	first := stream.Msg()
+	path := first.GetPath()
+	name := first.GetName()
+	_, target, err := s.resolveAttachment(path, name)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Dir(target)
+	if !first.GetOverwrite() {
+		_, err = os.Lstat(target)
+		if err == nil {
+			return nil, attachmentExists("UploadAttachment", path, name)
+		}
+	}
+
+	err = os.MkdirAll(dir, 0777)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	// the upload goes to a temporary file first so a failed or oversized
+	// upload never replaces an existing attachment
+	tmp, err := os.CreateTemp(dir, ".tmp-upload-*")
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	var size int64
+	write := func(data []byte) error {
+		size += int64(len(data))
+		if s.maxAttachmentSize > 0 && size > s.maxAttachmentSize {
+			return connect.NewError(
+				connect.CodeResourceExhausted,
+				fmt.Errorf("UploadAttachment: attachments cannot be larger than %d bytes", s.maxAttachmentSize),
+			)
+		}
+		_, err := tmp.Write(data)
+		return err
+	}
+	err = write(first.GetData())
+	for err == nil && stream.Receive() {
+		err = write(stream.Msg().GetData())
+	}
+	if err == nil {
+		err = stream.Err()
+	}
+	closeErr := tmp.Close()
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	if closeErr != nil {
+		return nil, fsError(path, closeErr)
+	}
+
+	err = os.Chmod(tmp.Name(), 0600)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	err = os.Rename(tmp.Name(), target)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	info, err := os.Stat(target)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	s.record(stream.Peer(), stream.RequestHeader(), journalRecord{Operation: "UploadAttachment", Before: path, After: path, Detail: name})
+
+	return &connect.Response[v1.UploadAttachmentResponse]{
+		Msg: &v1.UploadAttachmentResponse{
+			Attachment: readAttachment(target, info),
+		},
+	}, nil
+}
+
+func (s Service) DownloadAttachment(ctx context.Context, req *connect.Request[v1.DownloadAttachmentRequest], stream *connect.ServerStream[v1.DownloadAttachmentResponse]) error {
+	path := req.Msg.GetPath()
+	name := req.Msg.GetName()
+	_, target, err := s.resolveAttachment(path, name)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(target)
+	if errors.Is(err, os.ErrNotExist) {
+		return attachmentNotFound("DownloadAttachment", path, name)
+	}
+	if err != nil {
+		return fsError(path, err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return fsError(path, err)
+	}
+	if !info.Mode().IsRegular() {
+		return attachmentNotFound("DownloadAttachment", path, name)
+	}
+
+	// the first message always carries the attachment, even if it is empty
+	res := &v1.DownloadAttachmentResponse{
+		Attachment: readAttachment(target, info),
+	}
+	buf := make([]byte, attachmentChunkSize)
+	for {
+		n, readErr := f.Read(buf)
+		if n > 0 || res.Attachment != nil {
+			res.Data = buf[:n]
+			err = stream.Send(res)
+			if err != nil {
+				return err
+			}
+			res = &v1.DownloadAttachmentResponse{}
+		}
+		if errors.Is(readErr, io.EOF) {
+			return nil
+		}
+		if readErr != nil {
+			return fsError(path, readErr)
+		}
+	}
+}
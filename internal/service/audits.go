@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// auditRecord is stored as .archive/audits/<id>.json for every audit that
+// has not been finished yet. Entries are referred to by their uid so they can
+// be moved while the audit is running, the root container has the empty uid.
+type auditRecord struct {
+	Scope     string    `json:"scope"`
+	StartedAt time.Time `json:"started_at"`
+	// Seen maps the uid of every item that was seen to the uid of the
+	// container it was seen in.
+	Seen map[string]string `json:"seen,omitempty"`
+}
+
+// audits keeps the audits that are running under .archive/audits.
+type audits struct {
+	dir string
+	mu  sync.Mutex
+}
+
+func newAudits(root string) *audits {
+	return &audits{
+		dir: filepath.Join(root, stateDir, "audits"),
+	}
+}
+
+func (a *audits) recordPath(id string) string {
+	return filepath.Join(a.dir, id+".json")
+}
+
+// create stores a new audit and returns its id.
+func (a *audits) create(record auditRecord) (string, error) {
+	err := os.MkdirAll(a.dir, 0777)
+	if err != nil {
+		return "", fmt.Errorf("audits.create: %w", err)
+	}
+	// ids are based on the time, audits started at the same time get the
+	// next free id instead
+	for n := record.StartedAt.UnixNano(); ; n++ {
+		id := strconv.FormatInt(n, 10)
+		f, err := os.OpenFile(a.recordPath(id), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if errors.Is(err, os.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("audits.create: %w", err)
+		}
+		f.Close()
+		err = a.write(id, record)
+		if err != nil {
+			return "", fmt.Errorf("audits.create: %w", err)
+		}
+		return id, nil
+	}
+}
+
+func (a *audits) read(id string) (auditRecord, error) {
+	var record auditRecord
+	if !validAuditID(id) {
+		return record, fmt.Errorf("audits.read: invalid audit id '%s': %w", id, os.ErrNotExist)
+	}
+	contents, err := os.ReadFile(a.recordPath(id))
+	if err != nil {
+		return record, fmt.Errorf("audits.read: %w", err)
+	}
+	err = json.Unmarshal(contents, &record)
+	if err != nil {
+		return record, fmt.Errorf("audits.read: %w", err)
+	}
+	return record, nil
+}
+
+func (a *audits) write(id string, record auditRecord) error {
+	contents, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("audits.write: %w", err)
+	}
+	err = os.WriteFile(a.recordPath(id), contents, 0600)
+	if err != nil {
+		return fmt.Errorf("audits.write: %w", err)
+	}
+	return nil
+}
+
+func (a *audits) remove(id string) error {
+	err := os.Remove(a.recordPath(id))
+	if err != nil {
+		return fmt.Errorf("audits.remove: %w", err)
+	}
+	return nil
+}
+
+// validAuditID checks that id is an audit id generated by create, so it
+// cannot be used to reach outside of the audits directory.
+func validAuditID(id string) bool {
+	return isDigits(id)
+}
+
+// readAudit reads the audit with the given id, reporting a missing audit as
+// not found.
+func (s Service) readAudit(op, id string) (auditRecord, error) {
+	record, err := s.audits.read(id)
+	if errors.Is(err, os.ErrNotExist) {
+		return record, connect.NewError(connect.CodeNotFound, fmt.Errorf("%s: there is no running audit with the id '%s'", op, id))
+	}
+	if err != nil {
+		return record, connect.NewError(connect.CodeInternal, fmt.Errorf("%s: %w", op, err))
+	}
+	return record, nil
+}
+
+// entryUID returns the uid of the entry at path, assigning it one if it does
+// not have one yet.
+func (s Service) entryUID(path []string) (string, error) {
+	if len(path) == 0 {
+		return "", nil
+	}
+	for attempt := 0; attempt < 2; attempt++ {
+		e, ok := s.index.get(path)
+		if !ok {
+			return "", fsError(path, &os.PathError{Op: "read", Path: s.index.fpath(path), Err: os.ErrNotExist})
+		}
+		if uid := e.meta.GetUid(); uid != "" {
+			return uid, nil
+		}
+		s.assignUIDs(path)
+	}
+	return "", connect.NewError(connect.CodeInternal, fmt.Errorf("entryUID: could not assign a uid to %v", path))
+}
+
+// uidPath is like index.uid but also accepts the empty uid of the root.
+func (s Service) uidPath(uid string) ([]string, bool) {
+	if uid == "" {
+		return nil, true
+	}
+	return s.index.uid(uid)
+}
+
+// auditScope returns the path of the container an audit is running on.
+func (s Service) auditScope(op string, record auditRecord) ([]string, error) {
+	scope, ok := s.uidPath(record.Scope)
+	if !ok {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf("%s: the container the audit was started on no longer exists", op),
+		)
+	}
+	return scope, nil
+}
+
+// auditedItems returns the path and uid of every item in scope.
+func (s Service) auditedItems(scope []string) (paths [][]string, uids []string) {
+	prefix := indexKey(scope)
+	s.index.walk(func(path []string, e *indexEntry) bool {
+		if e.isContainer || !isWithinKey(indexKey(path), prefix) {
+			return true
+		}
+		paths = append(paths, slices.Clone(path))
+		uids = append(uids, e.meta.GetUid())
+		return true
+	})
+	return paths, uids
+}
+
+// auditItem compares where the item at path is with where it was seen,
+// seen is false if the item was not seen.
+func (s Service) auditItem(path []string, locationUID string, seen bool) *v1.AuditItem {
+	item := &v1.AuditItem{
+		Status: v1.AuditItem_MISSING,
+		Path:   path,
+	}
+	if !seen {
+		return item
+	}
+	location, ok := s.uidPath(locationUID)
+	if !ok {
+		// the container it was seen in is gone, so there is nowhere to put it
+		return item
+	}
+	item.Location = location
+	item.Status = v1.AuditItem_FOUND_ELSEWHERE
+	if indexKey(path[:len(path)-1]) == indexKey(location) {
+		item.Status = v1.AuditItem_CONFIRMED
+	}
+	return item
+}
+
+func (s Service) StartAudit(ctx context.Context, req *connect.Request[v1.StartAuditRequest]) (*connect.Response[v1.StartAuditResponse], error) {
+	scope := req.Msg.GetPath()
+	fpath, err := s.resolveContainer(scope)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.index.get(scope); !ok {
+		return nil, fsError(scope, &os.PathError{Op: "read", Path: fpath, Err: os.ErrNotExist})
+	}
+	scopeUID, err := s.entryUID(scope)
+	if err != nil {
+		return nil, err
+	}
+	// items are tracked by their uid, so every item needs one from the start
+	s.assignUIDs(scope)
+	paths, _ := s.auditedItems(scope)
+
+	s.audits.mu.Lock()
+	defer s.audits.mu.Unlock()
+	id, err := s.audits.create(auditRecord{Scope: scopeUID, StartedAt: time.Now()})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("StartAudit: %w", err))
+	}
+	return &connect.Response[v1.StartAuditResponse]{
+		Msg: &v1.StartAuditResponse{
+			Id:       id,
+			Expected: uint32(len(paths)),
+		},
+	}, nil
+}
+
+func (s Service) MarkSeen(ctx context.Context, req *connect.Request[v1.MarkSeenRequest]) (*connect.Response[v1.MarkSeenResponse], error) {
+	id := req.Msg.GetId()
+	path := req.Msg.GetPath()
+	location := req.Msg.GetLocation()
+
+	_, err := s.resolveEntry(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(path[len(path)-1], ".container") {
+		return nil, entryError(
+			connect.CodeInvalidArgument,
+			v1.EntryError_INVALID_PATH,
+			path,
+			fmt.Errorf("MarkSeen: only items can be marked as seen"),
+		)
+	}
+	_, err = s.resolveContainer(location)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := s.entryUID(path)
+	if err != nil {
+		return nil, err
+	}
+	locationUID, err := s.entryUID(location)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audits.mu.Lock()
+	defer s.audits.mu.Unlock()
+	record, err := s.readAudit("MarkSeen", id)
+	if err != nil {
+		return nil, err
+	}
+	if record.Seen == nil {
+		record.Seen = make(map[string]string)
+	}
+	record.Seen[uid] = locationUID
+	err = s.audits.write(id, record)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("MarkSeen: %w", err))
+	}
+
+	return &connect.Response[v1.MarkSeenResponse]{
+		Msg: &v1.MarkSeenResponse{
+			Item: s.auditItem(path, locationUID, true),
+		},
+	}, nil
+}
+
+func (s Service) FinishAudit(ctx context.Context, req *connect.Request[v1.FinishAuditRequest]) (*connect.Response[v1.FinishAuditResponse], error) {
+	id := req.Msg.GetId()
+
+	s.audits.mu.Lock()
+	defer s.audits.mu.Unlock()
+	record, err := s.readAudit("FinishAudit", id)
+	if err != nil {
+		return nil, err
+	}
+	scope, err := s.auditScope("FinishAudit", record)
+	if err != nil {
+		return nil, err
+	}
+
+	// items that showed up while the audit was running need a uid to be
+	// matched with the items that were seen
+	s.assignUIDs(scope)
+	paths, uids := s.auditedItems(scope)
+	var items []*v1.AuditItem
+	for i, path := range paths {
+		locationUID, seen := record.Seen[uids[i]]
+		items = append(items, s.auditItem(path, locationUID, seen))
+	}
+	for uid, locationUID := range record.Seen {
+		if slices.Contains(uids, uid) {
+			continue
+		}
+		path, ok := s.index.uid(uid)
+		if !ok {
+			// the item was deleted since it was seen
+			continue
+		}
+		items = append(items, s.auditItem(path, locationUID, true))
+	}
+	slices.SortFunc(items, func(a, b *v1.AuditItem) int {
+		return strings.Compare(indexKey(a.GetPath()), indexKey(b.GetPath()))
+	})
+
+	var moves []*v1.MoveRequest
+	for _, item := range items {
+		if item.GetStatus() != v1.AuditItem_FOUND_ELSEWHERE {
+			continue
+		}
+		path := item.GetPath()
+		moves = append(moves, &v1.MoveRequest{
+			Src:  path,
+			Dest: append(slices.Clone(item.GetLocation()), path[len(path)-1]),
+		})
+	}
+
+	err = s.audits.remove(id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("FinishAudit: %w", err))
+	}
+	return &connect.Response[v1.FinishAuditResponse]{
+		Msg: &v1.FinishAuditResponse{
+			Items: items,
+			Moves: moves,
+		},
+	}, nil
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"math"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+)
+
+// CalendarPattern is the http.ServeMux pattern ServeCalendar should be registered with.
+const CalendarPattern = "GET /calendar.ics"
+
+// defaultUpcomingDays is the window Upcoming uses when no window is given.
+const defaultUpcomingDays = 30
+
+// deadlineField is a date field that marks a deadline, summary describes the
+// deadline of an entry given its id.
+type deadlineField struct {
+	key     string
+	summary string
+}
+
+// deadline_fields are the fields Upcoming and the calendar look at by default.
+var deadline_fields = []deadlineField{
+	{"expires", "%s expires"},
+	{"warranty_until", "The warranty of %s ends"},
+	{"next_maintenance", "%s is due for maintenance"},
+}
+
+// deadlineSummary describes the date in field of the entry with the given
+// id, for use as the title of a calendar event.
+func deadlineSummary(field, id string) string {
+	for _, f := range deadline_fields {
+		if f.key == field {
+			return fmt.Sprintf(f.summary, id)
+		}
+	}
+	return fmt.Sprintf("%s: %s", id, field)
+}
+
+// startOfDay returns midnight at the start of the day of t.
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// daysUntil returns the number of days from today until date, which is
+// formatted as YYYY-MM-DD.
+func daysUntil(date string, today time.Time) (int, error) {
+	t, err := time.ParseInLocation(dateLayout, date, today.Location())
+	if err != nil {
+		return 0, fmt.Errorf("daysUntil: %w", err)
+	}
+	// days are not always 24 hours long when the clocks change
+	return int(math.Round(t.Sub(startOfDay(today)).Hours() / 24)), nil
+}
+
+// datedEntries returns an entry for every date field in fields of the entries
+// in scope whose date is between from and to, inclusive. Empty bounds are
+// unbounded.
+func (s Service) datedEntries(scope []string, fields []string, from, to string) []*v1.UpcomingResponse_Entry {
+	prefix := indexKey(scope)
+	now := time.Now()
+	var entries []*v1.UpcomingResponse_Entry
+	s.index.walk(func(path []string, e *indexEntry) bool {
+		if !isWithinKey(indexKey(path), prefix) {
+			return true
+		}
+		for _, key := range fields {
+			date, ok := e.meta.GetFields()[key].GetKind().(*v1.FieldValue_Date)
+			// dates are formatted as YYYY-MM-DD so they sort alphabetically
+			if !ok || (from != "" && date.Date < from) || (to != "" && date.Date > to) {
+				continue
+			}
+			days, err := daysUntil(date.Date, now)
+			if err != nil {
+				continue
+			}
+			entries = append(entries, &v1.UpcomingResponse_Entry{
+				Path:     path,
+				Meta:     e.metadata(),
+				Field:    key,
+				Date:     date.Date,
+				DaysLeft: int32(days),
+			})
+		}
+		return true
+	})
+	slices.SortStableFunc(entries, func(a, b *v1.UpcomingResponse_Entry) int {
+		return cmp.Compare(a.GetDate(), b.GetDate())
+	})
+	return entries
+}
+
+func deadlineKeys() []string {
+	keys := make([]string, len(deadline_fields))
+	for i, f := range deadline_fields {
+		keys[i] = f.key
+	}
+	return keys
+}
+
+func (s Service) Upcoming(ctx context.Context, req *connect.Request[v1.UpcomingRequest]) (*connect.Response[v1.UpcomingResponse], error) {
+	scope := req.Msg.GetPath()
+	fields := req.Msg.GetFields()
+	for _, key := range fields {
+		err := validateFieldKey(key)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Upcoming: %w", err))
+		}
+	}
+	if len(fields) == 0 {
+		fields = deadlineKeys()
+	}
+	_, err := s.resolve(scope)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.index.get(scope); !ok {
+		return nil, fsError(scope, &os.PathError{Op: "read", Path: s.index.fpath(scope), Err: os.ErrNotExist})
+	}
+
+	days := int(req.Msg.GetDays())
+	if days == 0 {
+		days = defaultUpcomingDays
+	}
+	now := time.Now()
+	from := now.Format(dateLayout)
+	if req.Msg.GetIncludePast() {
+		from = ""
+	}
+	to := now.AddDate(0, 0, days).Format(dateLayout)
+
+	return &connect.Response[v1.UpcomingResponse]{
+		Msg: &v1.UpcomingResponse{
+			Entries: s.datedEntries(scope, fields, from, to),
+		},
+	}, nil
+}
+
+// icsText escapes text for use in an iCalendar property value.
+func icsText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
+
+// writeICSLine writes a content line of an iCalendar file, folding it so no
+// line is longer than 75 octets without splitting a character.
+func writeICSLine(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines start with a space, which counts towards the limit
+		limit = 74
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+// ServeCalendar serves an iCalendar feed with an all day event for every
+// deadline in the archive, so it can be subscribed to from a calendar app.
+func (s Service) ServeCalendar(w http.ResponseWriter, r *http.Request) {
+	entries := s.datedEntries(nil, deadlineKeys(), "", "")
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+
+	var b strings.Builder
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//item-archived//deadlines//EN")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	writeICSLine(&b, "X-WR-CALNAME:Item archive")
+	for _, e := range entries {
+		date, err := time.Parse(dateLayout, e.GetDate())
+		if err != nil {
+			continue
+		}
+		// the uid has to stay the same when the entry is moved so calendars
+		// update the event instead of adding another one
+		uid := e.GetMeta().GetUid()
+		if uid == "" {
+			sum := sha256.Sum256([]byte(indexKey(e.GetPath())))
+			uid = hex.EncodeToString(sum[:8])
+		}
+		writeICSLine(&b, "BEGIN:VEVENT")
+		writeICSLine(&b, fmt.Sprintf("UID:%s-%s@item-archived", uid, e.GetField()))
+		writeICSLine(&b, "DTSTAMP:"+stamp)
+		writeICSLine(&b, "DTSTART;VALUE=DATE:"+date.Format("20060102"))
+		writeICSLine(&b, "DTEND;VALUE=DATE:"+date.AddDate(0, 0, 1).Format("20060102"))
+		if rule, ok := maintenanceRule(e.GetMeta().GetFields()); ok && e.GetField() == "next_maintenance" {
+			writeICSLine(&b, "RRULE:"+rule.String())
+		}
+		writeICSLine(&b, "SUMMARY:"+icsText(deadlineSummary(e.GetField(), e.GetMeta().GetId())))
+		writeICSLine(&b, "DESCRIPTION:"+icsText(strings.Join(e.GetPath(), " / ")))
+		if e.GetMeta().GetUid() != "" {
+			writeICSLine(&b, "URL:"+s.entryLink(e.GetMeta().GetUid()))
+		}
+		writeICSLine(&b, "END:VEVENT")
+	}
+	writeICSLine(&b, "END:VCALENDAR")
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	_, _ = w.Write([]byte(b.String()))
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"errors"
+	v1 "item-archived/api/v1"
+	"log/slog"
+	"os"
+	"syscall"
+
+	"connectrpc.com/connect"
+)
+
+// entryError creates a connect error with an EntryError detail attached so
+// clients can tell which entry caused the error without parsing the message.
+func entryError(code connect.Code, reason v1.EntryError_Reason, path []string, err error) *connect.Error {
+	cerr := connect.NewError(code, err)
+	detail, detailErr := connect.NewErrorDetail(&v1.EntryError{
+		Reason: reason,
+		Path:   path,
+	})
+	if detailErr != nil {
+		slog.Warn("failed to create error detail", "err", detailErr)
+		return cerr
+	}
+	cerr.AddDetail(detail)
+	return cerr
+}
+
+// fsError maps an error returned by a filesystem operation on the entry at
+// path to the matching connect error code, errors that already are connect
+// errors are returned as is.
+func fsError(path []string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var cerr *connect.Error
+	if errors.As(err, &cerr) {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return entryError(connect.CodeNotFound, v1.EntryError_NOT_FOUND, path, err)
+	case errors.Is(err, os.ErrExist), errors.Is(err, syscall.ENOTEMPTY):
+		return entryError(connect.CodeAlreadyExists, v1.EntryError_ALREADY_EXISTS, path, err)
+	case errors.Is(err, os.ErrPermission):
+		return entryError(connect.CodePermissionDenied, v1.EntryError_PERMISSION_DENIED, path, err)
+	case errors.Is(err, syscall.ENOTDIR):
+		return entryError(connect.CodeFailedPrecondition, v1.EntryError_INVALID_PATH, path, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	v1 "item-archived/api/v1"
+	"strings"
+	"sync"
+)
+
+// eventBufferSize is the amount of events a subscriber can fall behind by
+// before it is dropped.
+const eventBufferSize = 256
+
+type eventSubscriber struct {
+	prefix string
+	events chan *v1.WatchResponse
+}
+
+// eventBroker fans out archive changes to every subscriber watching the
+// subtree the change happened in.
+type eventBroker struct {
+	mu          sync.Mutex
+	subscribers map[*eventSubscriber]struct{}
+}
+
+func newEventBroker() *eventBroker {
+	return &eventBroker{
+		subscribers: make(map[*eventSubscriber]struct{}),
+	}
+}
+
+// subscribe returns a channel of events for the subtree at path, the channel
+// is closed if the subscriber falls too far behind. The returned function
+// must be called once the subscriber is no longer interested in events.
+func (b *eventBroker) subscribe(path []string) (<-chan *v1.WatchResponse, func()) {
+	sub := &eventSubscriber{
+		prefix: indexKey(path),
+		events: make(chan *v1.WatchResponse, eventBufferSize),
+	}
+
+	b.mu.Lock()
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	return sub.events, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subscribers[sub]; ok {
+			delete(b.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+func (b *eventBroker) publish(event *v1.WatchResponse) {
+	key := indexKey(event.GetPath())
+	srcKey := indexKey(event.GetSrc())
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subscribers {
+		matches := isWithinKey(key, sub.prefix) ||
+			(event.GetType() == v1.WatchResponse_MOVED && isWithinKey(srcKey, sub.prefix))
+		if !matches {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			delete(b.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+// changeRoots returns the keys in changed whose parent did not change as well,
+// a change to a container implicitly applies to everything below it.
+func changeRoots(changed map[string]*indexEntry) []string {
+	var roots []string
+	for key := range changed {
+		parent := ""
+		if i := strings.LastIndex(key, "/"); i >= 0 {
+			parent = key[:i]
+		}
+		if _, ok := changed[parent]; ok && key != "" {
+			continue
+		}
+		roots = append(roots, key)
+	}
+	return roots
+}
+
+func splitKey(key string) []string {
+	if key == "" {
+		return nil
+	}
+	return strings.Split(key, "/")
+}
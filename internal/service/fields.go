@@ -0,0 +1,293 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// sidecarFilename is the name of the optional file in an entry directory that
+// holds structured metadata that does not fit into the filename.
+const sidecarFilename = "meta.json"
+
+// dateLayout is the format of date field values.
+const dateLayout = time.DateOnly
+
+// sidecar is the contents of meta.json, fields are stored as plain json
+// values so the file stays easy to edit by hand:
+//
+//	{
+//	  "uid": "k7vq2xj4m3nd6pby",
+//	  "fields": {
+//	    "brand": "bosch",
+//	    "price": 59.99,
+//	    "purchase_date": "2024-05-01",
+//	    "last_tuned": {"date": "2025-03-14"}
+//	  }
+//	}
+//
+// The known date fields like purchase_date are stored as plain strings, other
+// dates are wrapped in an object so they are not mistaken for text.
+type sidecar struct {
+	// UID is the unique id of the entry, see uids.go
+	UID    string         `json:"uid,omitempty"`
+	Fields map[string]any `json:"fields,omitempty"`
+	// QuantityHistory holds the most recent changes made by AdjustQuantity,
+	// see quantity.go
+	QuantityHistory []quantityChange `json:"quantity_history,omitempty"`
+	// MaintenanceHistory holds the most recent maintenance logged by
+	// LogMaintenance, see maintenance.go
+	MaintenanceHistory []maintenanceRecord `json:"maintenance_history,omitempty"`
+	// Loan is set while the entry is checked out, see loans.go
+	Loan *loan `json:"loan,omitempty"`
+}
+
+func (sc *sidecar) empty() bool {
+	return sc.UID == "" && len(sc.Fields) == 0 && len(sc.QuantityHistory) == 0 &&
+		len(sc.MaintenanceHistory) == 0 && sc.Loan == nil
+}
+
+type fieldKind int
+
+const (
+	fieldText fieldKind = iota
+	fieldNumber
+	fieldBoolean
+	fieldDate
+)
+
+func (k fieldKind) String() string {
+	switch k {
+	case fieldNumber:
+		return "number"
+	case fieldBoolean:
+		return "boolean"
+	case fieldDate:
+		return "date"
+	}
+	return "text"
+}
+
+// known_fields maps the fields with a well known meaning to the kind of value
+// they must have.
+var known_fields = map[string]fieldKind{
+	"quantity":      fieldNumber,
+	"min_quantity":  fieldNumber,
+	"unit":          fieldText,
+	"price":         fieldNumber,
+	"purchase_date": fieldDate,
+	"serial_number": fieldText,
+	"brand":         fieldText,
+	// the deadlines listed by Upcoming, see deadlines.go
+	"expires":          fieldDate,
+	"warranty_until":   fieldDate,
+	"next_maintenance": fieldDate,
+	// how often next_maintenance comes around, see maintenance.go
+	"maintenance_rule": fieldText,
+}
+
+func fieldValueKind(value *v1.FieldValue) (fieldKind, bool) {
+	switch value.GetKind().(type) {
+	case *v1.FieldValue_Text:
+		return fieldText, true
+	case *v1.FieldValue_Number:
+		return fieldNumber, true
+	case *v1.FieldValue_Boolean:
+		return fieldBoolean, true
+	case *v1.FieldValue_Date:
+		return fieldDate, true
+	}
+	return 0, false
+}
+
+// validateFieldKey checks that key can be used as the key of a field, keys
+// cannot shadow the built in search fields so every field can be searched.
+func validateFieldKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("field key cannot be empty")
+	}
+	for _, r := range key {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_') {
+			return fmt.Errorf("field key \"%s\" may only contain lowercase letters, digits and underscores", key)
+		}
+	}
+	if slices.Contains(queryFields, key) {
+		return fmt.Errorf("field key \"%s\" is reserved for searching", key)
+	}
+	return nil
+}
+
+func validateFields(fields map[string]*v1.FieldValue) error {
+	for key, value := range fields {
+		err := validateFieldKey(key)
+		if err != nil {
+			return err
+		}
+		kind, ok := fieldValueKind(value)
+		if !ok {
+			return fmt.Errorf("field \"%s\" is missing a value", key)
+		}
+		if want, ok := known_fields[key]; ok && kind != want {
+			return fmt.Errorf("field \"%s\" must be a %s, got a %s", key, want, kind)
+		}
+		switch kind {
+		case fieldNumber:
+			if math.IsNaN(value.GetNumber()) || math.IsInf(value.GetNumber(), 0) {
+				return fmt.Errorf("field \"%s\" must be a finite number", key)
+			}
+		case fieldDate:
+			_, err = time.Parse(dateLayout, value.GetDate())
+			if err != nil {
+				return fmt.Errorf("field \"%s\" must be formatted as YYYY-MM-DD: %w", key, err)
+			}
+		}
+		if key == "maintenance_rule" {
+			_, err = parseRecurrence(value.GetText())
+			if err != nil {
+				return fmt.Errorf("field \"%s\" is not a valid rule: %w", key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// fieldsFromSidecar converts the json values of a sidecar to field values,
+// values that cannot be represented as a field are skipped.
+func fieldsFromSidecar(fpath string, sc *sidecar) map[string]*v1.FieldValue {
+	if len(sc.Fields) == 0 {
+		return nil
+	}
+	fields := make(map[string]*v1.FieldValue, len(sc.Fields))
+	for key, raw := range sc.Fields {
+		value, ok := fieldFromSidecar(key, raw)
+		if !ok {
+			slog.Warn("ignoring field with unsupported value", "filepath", fpath, "field", key)
+			continue
+		}
+		fields[key] = value
+	}
+	return fields
+}
+
+// fieldFromSidecar converts the json value of a field. The kind of a value is
+// decided by how it is stored, strings are only dates for the known date
+// fields, other date fields are stored as {"date": "YYYY-MM-DD"} so a text
+// that happens to look like a date stays text.
+func fieldFromSidecar(key string, raw any) (*v1.FieldValue, bool) {
+	want, known := known_fields[key]
+	var value *v1.FieldValue
+	switch raw := raw.(type) {
+	case string:
+		if known && want == fieldDate {
+			value = &v1.FieldValue{Kind: &v1.FieldValue_Date{Date: raw}}
+		} else {
+			value = &v1.FieldValue{Kind: &v1.FieldValue_Text{Text: raw}}
+		}
+	case float64:
+		value = &v1.FieldValue{Kind: &v1.FieldValue_Number{Number: raw}}
+	case bool:
+		value = &v1.FieldValue{Kind: &v1.FieldValue_Boolean{Boolean: raw}}
+	case map[string]any:
+		date, ok := raw["date"].(string)
+		if !ok || len(raw) != 1 {
+			return nil, false
+		}
+		value = &v1.FieldValue{Kind: &v1.FieldValue_Date{Date: date}}
+	default:
+		return nil, false
+	}
+	// fields that were edited by hand may have the wrong kind of value or a
+	// malformed date
+	if kind, _ := fieldValueKind(value); known && kind != want {
+		return nil, false
+	}
+	if date, ok := value.GetKind().(*v1.FieldValue_Date); ok {
+		if _, err := time.Parse(dateLayout, date.Date); err != nil {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+func fieldsToSidecar(fields map[string]*v1.FieldValue) map[string]any {
+	if len(fields) == 0 {
+		return nil
+	}
+	raw := make(map[string]any, len(fields))
+	for key, value := range fields {
+		switch v := value.GetKind().(type) {
+		case *v1.FieldValue_Text:
+			raw[key] = v.Text
+		case *v1.FieldValue_Number:
+			raw[key] = v.Number
+		case *v1.FieldValue_Boolean:
+			raw[key] = v.Boolean
+		case *v1.FieldValue_Date:
+			if known_fields[key] == fieldDate {
+				raw[key] = v.Date
+			} else {
+				raw[key] = map[string]any{"date": v.Date}
+			}
+		}
+	}
+	return raw
+}
+
+// readSidecar reads the sidecar of the entry at fpath, a missing sidecar is
+// the same as an empty one.
+func readSidecar(fpath string) (*sidecar, error) {
+	sc := &sidecar{}
+	contents, err := os.ReadFile(filepath.Join(fpath, sidecarFilename))
+	if errors.Is(err, os.ErrNotExist) {
+		return sc, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("readSidecar: %w", err)
+	}
+	err = json.Unmarshal(contents, sc)
+	if err != nil {
+		return nil, fmt.Errorf("readSidecar: %w", err)
+	}
+	return sc, nil
+}
+
+// writeSidecar replaces the sidecar of the entry at fpath, an empty sidecar
+// removes the file.
+func writeSidecar(fpath string, sc *sidecar) error {
+	target := filepath.Join(fpath, sidecarFilename)
+	if sc.empty() {
+		err := os.Remove(target)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("writeSidecar: %w", err)
+		}
+		return nil
+	}
+
+	contents, err := json.MarshalIndent(sc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("writeSidecar: %w", err)
+	}
+	// write to a temporary file first so the sidecar is never seen half written
+	tmp, err := os.CreateTemp(fpath, ".tmp-meta-*")
+	if err != nil {
+		return fmt.Errorf("writeSidecar: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(append(contents, '\n'))
+	closeErr := tmp.Close()
+	if err != nil || closeErr != nil {
+		return fmt.Errorf("writeSidecar: %w", errors.Join(err, closeErr))
+	}
+	err = os.Rename(tmp.Name(), target)
+	if err != nil {
+		return fmt.Errorf("writeSidecar: %w", err)
+	}
+	return nil
+}
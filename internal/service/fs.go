@@ -1,12 +1,17 @@
 package service
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	v1 "item-archived/api/v1"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -45,28 +50,24 @@ func formatFilename(id string, tags []string, isContainer bool) string {
 	return strings.Join(segments, ".")
 }
 
-func readEntryMeta(fpath string) (*v1.EntryMetadata, error) {
+// readEntryMeta reads the metadata of the entry at fpath, the images
+// themselves are not read, instead the paths to the image files are returned
+// in order, starting with the primary image.
+func readEntryMeta(fpath string) (meta *v1.EntryMetadata, imagePaths []string, err error) {
 	_, filename := filepath.Split(fpath)
 	id, tags, _, err := parseFilename(filename)
 	if err != nil {
-		return nil, fmt.Errorf("readEntryMeta: %w", err)
+		return nil, nil, fmt.Errorf("readEntryMeta: %w", err)
 	}
 
+	imagePaths, err = readImages(fpath)
+	if err != nil {
+		slog.Warn("failed to read images", "filepath", fpath, "err", err)
+	}
 	var imgFormat *v1.ImageFormat
-	var img []byte
-	for _, ext := range image_extensions {
-		imagePath := filepath.Join(fpath, fmt.Sprintf("image.%s", ext.ext))
-
-		img, err = os.ReadFile(imagePath)
-		if errors.Is(err, os.ErrNotExist) {
-			continue
-		}
-		if err != nil {
-			slog.Warn("failed to read image file", "filepath", imagePath, "err", err)
-			continue
-		}
-		imgFormat = &ext.format
-		break
+	if len(imagePaths) > 0 {
+		_, format, _ := parseImageFilename(filepath.Base(imagePaths[0]))
+		imgFormat = &format
 	}
 
 	var description *string
@@ -81,13 +82,27 @@ func readEntryMeta(fpath string) (*v1.EntryMetadata, error) {
 		description = &descContentsStr
 	}
 
+	var fields map[string]*v1.FieldValue
+	var uid string
+	var entryLoan *v1.Loan
+	sc, err := readSidecar(fpath)
+	if err != nil {
+		slog.Warn("failed to read sidecar", "filepath", fpath, "err", err)
+	} else {
+		fields = fieldsFromSidecar(fpath, sc)
+		uid = sc.UID
+		entryLoan = sc.Loan.proto()
+	}
+
 	return &v1.EntryMetadata{
 		Id:          id,
 		Tags:        tags,
 		Description: description,
-		Image:       img,
 		ImageFormat: imgFormat,
-	}, nil
+		Fields:      fields,
+		Uid:         uid,
+		Loan:        entryLoan,
+	}, imagePaths, nil
 }
 
 func writeEntryMeta(fpath string, meta *v1.EntryMetadata, isContainer bool) error {
@@ -108,15 +123,8 @@ func writeEntryMeta(fpath string, meta *v1.EntryMetadata, isContainer bool) erro
 	}
 
 	if len(meta.GetImage()) > 0 {
-		imgExt := ""
-		for _, ext := range image_extensions {
-			if ext.format == meta.GetImageFormat() {
-				imgExt = ext.ext
-				break
-			}
-		}
 		err = os.WriteFile(
-			filepath.Join(fpath, filename, fmt.Sprintf("image.%s", imgExt)),
+			filepath.Join(fpath, filename, formatImageFilename(0, meta.GetImageFormat())),
 			meta.GetImage(),
 			0600,
 		)
@@ -125,9 +133,298 @@ func writeEntryMeta(fpath string, meta *v1.EntryMetadata, isContainer bool) erro
 		}
 	}
 
+	err = writeSidecar(filepath.Join(fpath, filename), &sidecar{
+		UID:    newUID(),
+		Fields: fieldsToSidecar(meta.GetFields()),
+	})
+	if err != nil {
+		return fmt.Errorf("writeEntryMeta: %w", err)
+	}
+
 	return nil
 }
 
+func imageExtension(format v1.ImageFormat) string {
+	for _, ext := range image_extensions {
+		if ext.format == format {
+			return ext.ext
+		}
+	}
+	return ""
+}
+
+// updateEntryMeta changes the fields of the existing entry at fpath that are
+// listed in fields, the directory is renamed if the id or tags change, in
+// which case the new path of the entry is returned.
+func updateEntryMeta(fpath string, meta *v1.EntryMetadata, fields []string) (string, error) {
+	parent, filename := filepath.Split(fpath)
+	id, tags, isContainer, err := parseFilename(filename)
+	if err != nil {
+		return "", fmt.Errorf("updateEntryMeta: %w", err)
+	}
+	_, err = os.Stat(fpath)
+	if err != nil {
+		return "", fmt.Errorf("updateEntryMeta: %w", err)
+	}
+
+	for _, field := range fields {
+		switch field {
+		case "id":
+			id = meta.GetId()
+		case "tags":
+			tags = meta.GetTags()
+		}
+	}
+	// the new name is checked before anything is written, so a rename that
+	// fails leaves the entry as it was
+	newFpath := filepath.Join(parent, formatFilename(id, tags, isContainer))
+	if newFpath != fpath {
+		_, err = os.Lstat(newFpath)
+		if err == nil {
+			return "", fmt.Errorf("updateEntryMeta: %w", &os.LinkError{
+				Op:  "rename",
+				Old: fpath,
+				New: newFpath,
+				Err: os.ErrExist,
+			})
+		}
+	}
+
+	replacedImage := false
+	for _, field := range fields {
+		switch field {
+		case "description":
+			descPath := filepath.Join(fpath, "description.txt")
+			if meta.Description == nil {
+				err = os.Remove(descPath)
+				if errors.Is(err, os.ErrNotExist) {
+					err = nil
+				}
+			} else {
+				err = os.WriteFile(descPath, []byte(meta.GetDescription()), 0600)
+			}
+			if err != nil {
+				return "", fmt.Errorf("updateEntryMeta: %w", err)
+			}
+		case "image", "image_format":
+			// replacing drops the primary image, so it must only happen
+			// once when both are listed
+			if replacedImage {
+				continue
+			}
+			err = replaceImage(fpath, meta.GetImage(), meta.GetImageFormat())
+			if err != nil {
+				return "", fmt.Errorf("updateEntryMeta: %w", err)
+			}
+			replacedImage = true
+		case "fields":
+			sc, err := readSidecar(fpath)
+			if err != nil {
+				return "", fmt.Errorf("updateEntryMeta: %w", err)
+			}
+			sc.Fields = fieldsToSidecar(meta.GetFields())
+			err = writeSidecar(fpath, sc)
+			if err != nil {
+				return "", fmt.Errorf("updateEntryMeta: %w", err)
+			}
+		}
+	}
+
+	if newFpath == fpath {
+		return fpath, nil
+	}
+	err = os.Rename(fpath, newFpath)
+	if err != nil {
+		return "", fmt.Errorf("updateEntryMeta: %w", err)
+	}
+	return newFpath, nil
+}
+
+// replaceImage replaces the primary image of the entry at fpath, the other
+// images are left untouched, an empty image removes the primary image so the
+// next image takes its place.
+func replaceImage(fpath string, img []byte, format v1.ImageFormat) error {
+	images, err := readImages(fpath)
+	if err != nil {
+		return fmt.Errorf("replaceImage: %w", err)
+	}
+	if len(images) > 0 {
+		images = images[1:]
+	}
+	if len(img) > 0 {
+		tmp, err := writeTempImage(fpath, img, format)
+		if err != nil {
+			return fmt.Errorf("replaceImage: %w", err)
+		}
+		images = append([]string{tmp}, images...)
+	}
+	err = arrangeImages(fpath, images)
+	if err != nil {
+		return fmt.Errorf("replaceImage: %w", err)
+	}
+	return nil
+}
+
+// parseImageFilename parses the name of an image file, the primary image is
+// named image.{ext} and is at position 0, further images are named
+// image-1.{ext}, image-2.{ext} and so on.
+func parseImageFilename(name string) (position int, format v1.ImageFormat, ok bool) {
+	base, ext, found := strings.Cut(name, ".")
+	if !found {
+		return 0, 0, false
+	}
+	for _, e := range image_extensions {
+		if e.ext != ext {
+			continue
+		}
+		if base == "image" {
+			return 0, e.format, true
+		}
+		n, found := strings.CutPrefix(base, "image-")
+		if !found {
+			return 0, 0, false
+		}
+		position, err := strconv.Atoi(n)
+		if err != nil || position < 1 || n != strconv.Itoa(position) {
+			return 0, 0, false
+		}
+		return position, e.format, true
+	}
+	return 0, 0, false
+}
+
+func formatImageFilename(position int, format v1.ImageFormat) string {
+	if position == 0 {
+		return fmt.Sprintf("image.%s", imageExtension(format))
+	}
+	return fmt.Sprintf("image-%d.%s", position, imageExtension(format))
+}
+
+// readImages returns the paths of the image files of the entry at fpath in
+// order, gaps in the numbering are skipped and images with the same number
+// are ordered by extension.
+func readImages(fpath string) ([]string, error) {
+	entries, err := os.ReadDir(fpath)
+	if err != nil {
+		return nil, fmt.Errorf("readImages: %w", err)
+	}
+	type image struct {
+		name     string
+		position int
+		format   v1.ImageFormat
+	}
+	var images []image
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		position, format, ok := parseImageFilename(e.Name())
+		if !ok {
+			continue
+		}
+		images = append(images, image{e.Name(), position, format})
+	}
+	slices.SortFunc(images, func(a, b image) int {
+		if a.position != b.position {
+			return a.position - b.position
+		}
+		return int(a.format) - int(b.format)
+	})
+	paths := make([]string, len(images))
+	for i, img := range images {
+		paths[i] = filepath.Join(fpath, img.name)
+	}
+	return paths, nil
+}
+
+// readImageHashes is like readImages, but also returns the hash of each image.
+func readImageHashes(fpath string) (paths []string, hashes []string, err error) {
+	paths, err = readImages(fpath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("readImageHashes: %w", err)
+	}
+	hashes = make([]string, len(paths))
+	for i, p := range paths {
+		hashes[i], err = hashFile(p)
+		if err != nil {
+			return nil, nil, fmt.Errorf("readImageHashes: %w", err)
+		}
+	}
+	return paths, hashes, nil
+}
+
+// writeTempImage writes an image into the entry at fpath under a temporary
+// name that is not picked up as an image until it is passed to arrangeImages.
+func writeTempImage(fpath string, img []byte, format v1.ImageFormat) (string, error) {
+	f, err := os.CreateTemp(fpath, fmt.Sprintf(".tmp-image-*.%s", imageExtension(format)))
+	if err != nil {
+		return "", fmt.Errorf("writeTempImage: %w", err)
+	}
+	_, err = f.Write(img)
+	closeErr := f.Close()
+	if err != nil || closeErr != nil {
+		os.Remove(f.Name())
+		return "", fmt.Errorf("writeTempImage: %w", errors.Join(err, closeErr))
+	}
+	return f.Name(), nil
+}
+
+// arrangeImages renames the image files at the given paths so they are
+// numbered in the given order, images of the entry at fpath that are not
+// listed are removed.
+func arrangeImages(fpath string, order []string) error {
+	current, err := readImages(fpath)
+	if err != nil {
+		return fmt.Errorf("arrangeImages: %w", err)
+	}
+	for _, p := range current {
+		if slices.Contains(order, p) {
+			continue
+		}
+		err = os.Remove(p)
+		if err != nil {
+			return fmt.Errorf("arrangeImages: %w", err)
+		}
+	}
+
+	// rename in two passes so an image never overwrites another image whose
+	// name it is about to take
+	staged := make([]string, len(order))
+	for i, p := range order {
+		ext := filepath.Ext(p)
+		staged[i] = filepath.Join(fpath, fmt.Sprintf(".tmp-arrange-%d%s", i, ext))
+		err = os.Rename(p, staged[i])
+		if err != nil {
+			return fmt.Errorf("arrangeImages: %w", err)
+		}
+	}
+	for i, p := range staged {
+		_, format, ok := parseImageFilename("image" + filepath.Ext(p))
+		if !ok {
+			return fmt.Errorf("arrangeImages: unsupported image '%s'", order[i])
+		}
+		err = os.Rename(p, filepath.Join(fpath, formatImageFilename(i, format)))
+		if err != nil {
+			return fmt.Errorf("arrangeImages: %w", err)
+		}
+	}
+	return nil
+}
+
+func hashFile(fpath string) (string, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return "", fmt.Errorf("hashFile: %w", err)
+	}
+	defer f.Close()
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", fmt.Errorf("hashFile: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 func readChildren(fpath string) (items []string, containers []string, err error) {
 	entries, err := os.ReadDir(fpath)
 	if err != nil {
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"errors"
+	v1 "item-archived/api/v1"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestUpdateEntryMetaImage(t *testing.T) {
+	tests := []struct {
+		name   string
+		image  string
+		fields []string
+		want   []string
+	}{
+		{"Replace", "new", []string{"image", "image_format"}, []string{"new", "second"}},
+		{"ReplaceFormatFirst", "new", []string{"image_format", "image"}, []string{"new", "second"}},
+		{"ReplaceImageOnly", "new", []string{"image"}, []string{"new", "second"}},
+		{"Remove", "", []string{"image", "image_format"}, []string{"second"}},
+		{"AllFields", "new", updatableFields, []string{"new", "second"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fpath := filepath.Join(t.TempDir(), "drill.item")
+			os.Mkdir(fpath, 0777)
+			os.WriteFile(filepath.Join(fpath, "image.png"), []byte("first"), 0666)
+			os.WriteFile(filepath.Join(fpath, "image-1.png"), []byte("second"), 0666)
+			meta := &v1.EntryMetadata{Id: "drill", Image: []byte(test.image), ImageFormat: v1.ImageFormat_PNG.Enum()}
+			_, err := updateEntryMeta(fpath, meta, test.fields)
+			if err != nil {
+				t.Fatal(err)
+			}
+			images, err := readImages(fpath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, p := range images {
+				contents, err := os.ReadFile(p)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, string(contents))
+			}
+			if !slices.Equal(got, test.want) {
+				t.Errorf("images are %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestUpdateEntryMetaCollision(t *testing.T) {
+	dir := t.TempDir()
+	fpath := filepath.Join(dir, "drill.item")
+	os.Mkdir(fpath, 0777)
+	os.Mkdir(filepath.Join(dir, "saw.item"), 0777)
+	os.WriteFile(filepath.Join(fpath, "description.txt"), []byte("old"), 0666)
+	description := "new"
+	meta := &v1.EntryMetadata{Id: "saw", Description: &description}
+	_, err := updateEntryMeta(fpath, meta, []string{"description", "id"})
+	if !errors.Is(err, os.ErrExist) {
+		t.Fatalf("updateEntryMeta returned %v, want an error about the existing entry", err)
+	}
+	contents, err := os.ReadFile(filepath.Join(fpath, "description.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(contents) != "old" {
+		t.Errorf("description is %q after the failed update, want %q", contents, "old")
+	}
+}
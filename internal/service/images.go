@@ -0,0 +1,72 @@
+package service
+
+import (
+	"fmt"
+	v1 "item-archived/api/v1"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// ImagePattern is the http.ServeMux pattern ServeImage should be registered with.
+const ImagePattern = "GET /images/{hash}"
+
+var image_mime_types = map[v1.ImageFormat]string{
+	v1.ImageFormat_JPG: "image/jpeg",
+	v1.ImageFormat_PNG: "image/png",
+	v1.ImageFormat_GIF: "image/gif",
+	v1.ImageFormat_SVG: "image/svg+xml",
+}
+
+func imageURL(hash string) string {
+	return fmt.Sprintf("/images/%s", hash)
+}
+
+// ServeImage serves the image referenced by an ImageRef, images are
+// addressed by their content hash so they can be cached indefinitely.
+func (s Service) ServeImage(w http.ResponseWriter, r *http.Request) {
+	hash := r.PathValue("hash")
+	fpath, ok := s.index.image(hash)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	serveImageFile(w, r, fpath, hash)
+}
+
+// serveImageFile serves the image at fpath with caching headers, the etag
+// must change whenever the contents of the file do.
+func serveImageFile(w http.ResponseWriter, r *http.Request, fpath, etag string) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		slog.Warn("failed to open image", "filepath", fpath, "err", err)
+		http.NotFound(w, r)
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		slog.Warn("failed to stat image", "filepath", fpath, "err", err)
+		http.Error(w, "failed to read image", http.StatusInternalServerError)
+		return
+	}
+
+	for _, ext := range image_extensions {
+		if filepath.Ext(fpath) == "."+ext.ext {
+			w.Header().Set("Content-Type", image_mime_types[ext.format])
+			if ext.format == v1.ImageFormat_SVG {
+				// svgs can contain scripts, opened directly they would run
+				// on the origin of the api
+				w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; sandbox")
+			}
+			break
+		}
+	}
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("ETag", fmt.Sprintf("\"%s\"", etag))
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	// ServeContent takes care of range requests and conditional requests
+	http.ServeContent(w, r, info.Name(), info.ModTime(), f)
+}
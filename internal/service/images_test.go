@@ -0,0 +1,44 @@
+package service
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServeImageHeaders(t *testing.T) {
+	s := newTestService(t, map[string]string{
+		"drill.item/image.svg": `<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`,
+		"saw.item/image.png":   "not really a png",
+	})
+	tests := []struct {
+		entry   string
+		sandbox bool
+	}{
+		{"drill.item", true},
+		{"saw.item", false},
+	}
+	for _, test := range tests {
+		t.Run(test.entry, func(t *testing.T) {
+			e, ok := s.index.get([]string{test.entry})
+			if !ok || len(e.meta.GetImages()) != 1 {
+				t.Fatal("the image is not in the index")
+			}
+			hash := e.meta.GetImages()[0].GetHash()
+			req := httptest.NewRequest("GET", "/images/"+hash, nil)
+			req.SetPathValue("hash", hash)
+			rec := httptest.NewRecorder()
+			s.ServeImage(rec, req)
+			if rec.Code != 200 {
+				t.Fatalf("status is %d, want 200", rec.Code)
+			}
+			if got := rec.Header().Get("X-Content-Type-Options"); got != "nosniff" {
+				t.Errorf("X-Content-Type-Options is %q, want nosniff", got)
+			}
+			csp := rec.Header().Get("Content-Security-Policy")
+			if sandboxed := strings.Contains(csp, "sandbox"); sandboxed != test.sandbox {
+				t.Errorf("Content-Security-Policy is %q, sandboxed should be %v", csp, test.sandbox)
+			}
+		})
+	}
+}
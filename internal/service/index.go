@@ -0,0 +1,566 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"google.golang.org/protobuf/proto"
+)
+
+// indexEntry is a snapshot of a single entry, it must not be modified after
+// being added to the index, changes are made by replacing the entry instead.
+type indexEntry struct {
+	info        os.FileInfo
+	meta        *v1.EntryMetadata
+	imagePaths  []string
+	isContainer bool
+	items       []string
+	containers  []string
+}
+
+// metadata returns a copy of the entry's metadata.
+func (e *indexEntry) metadata() *v1.EntryMetadata {
+	return proto.Clone(e.meta).(*v1.EntryMetadata)
+}
+
+// readIndexedMeta is like readEntryMeta, but also hashes the images so that
+// they can be referenced by the metadata, images that cannot be hashed are
+// left out of both the metadata and the returned paths.
+func readIndexedMeta(fpath string) (*v1.EntryMetadata, []string, error) {
+	meta, imagePaths, err := readEntryMeta(fpath)
+	if err != nil {
+		return nil, nil, err
+	}
+	var hashed []string
+	for _, imagePath := range imagePaths {
+		hash, err := hashFile(imagePath)
+		if err != nil {
+			slog.Warn("failed to hash image file", "filepath", imagePath, "err", err)
+			continue
+		}
+		_, format, _ := parseImageFilename(filepath.Base(imagePath))
+		meta.Images = append(meta.Images, &v1.ImageRef{
+			Hash:       hash,
+			Format:     format,
+			Url:        imageURL(hash),
+			Thumbnails: thumbnailURLs(hash),
+		})
+		hashed = append(hashed, imagePath)
+	}
+	meta.ImageFormat = nil
+	if len(meta.Images) > 0 {
+		format := meta.Images[0].GetFormat()
+		meta.ImageRef = meta.Images[0]
+		meta.ImageFormat = &format
+	}
+	return meta, hashed, nil
+}
+
+// archiveIndex keeps the metadata of every entry in the archive in memory
+// and keeps it up to date by watching the archive directory for changes.
+type archiveIndex struct {
+	dir     string
+	watcher *fsnotify.Watcher
+	events  *eventBroker
+
+	mu      sync.RWMutex
+	entries map[string]*indexEntry
+	// images maps image hashes to the paths of the image files with that hash
+	images map[string]map[string]struct{}
+	// uids maps the uids of entries to their keys, if entries share a uid,
+	// like when a directory was copied, the original keeps it, see putLoaded
+	uids map[string]string
+	// pending holds entries that were removed recently, if they reappear
+	// somewhere else before their timer fires they are reported as moved
+	pending []*pendingDelete
+}
+
+// moveWindow is how long a removed entry waits for a matching creation before
+// it is reported as deleted.
+const moveWindow = 500 * time.Millisecond
+
+type pendingDelete struct {
+	path  []string
+	entry *indexEntry
+	timer *time.Timer
+}
+
+func indexKey(path []string) string {
+	return strings.Join(path, "/")
+}
+
+func isWithinKey(key, prefix string) bool {
+	return prefix == "" || key == prefix || strings.HasPrefix(key, prefix+"/")
+}
+
+func isEntryName(name string) bool {
+	return strings.HasSuffix(name, ".item") || strings.HasSuffix(name, ".container")
+}
+
+func newArchiveIndex(dir string) (*archiveIndex, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("newArchiveIndex: %w", err)
+	}
+	idx := &archiveIndex{
+		dir:     dir,
+		watcher: watcher,
+		events:  newEventBroker(),
+		entries: make(map[string]*indexEntry),
+		images:  make(map[string]map[string]struct{}),
+		uids:    make(map[string]string),
+	}
+	err = idx.sync(nil)
+	if err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("newArchiveIndex: %w", err)
+	}
+	if _, ok := idx.get(nil); !ok {
+		watcher.Close()
+		return nil, fmt.Errorf("newArchiveIndex: archive directory '%s' does not exist", dir)
+	}
+	go idx.watch()
+	return idx, nil
+}
+
+func (idx *archiveIndex) close() error {
+	return idx.watcher.Close()
+}
+
+func (idx *archiveIndex) fpath(path []string) string {
+	return filepath.Join(append([]string{idx.dir}, path...)...)
+}
+
+// put adds or replaces the entry at key, the caller must hold the write lock.
+func (idx *archiveIndex) put(key string, e *indexEntry) {
+	idx.remove(key)
+	idx.entries[key] = e
+	for i, img := range e.meta.GetImages() {
+		paths, ok := idx.images[img.GetHash()]
+		if !ok {
+			paths = make(map[string]struct{})
+			idx.images[img.GetHash()] = paths
+		}
+		paths[e.imagePaths[i]] = struct{}{}
+	}
+	if uid := e.meta.GetUid(); uid != "" {
+		if _, taken := idx.uids[uid]; !taken {
+			idx.uids[uid] = key
+		}
+	}
+}
+
+// remove removes the entry at key, the caller must hold the write lock.
+func (idx *archiveIndex) remove(key string) {
+	e, ok := idx.entries[key]
+	if !ok {
+		return
+	}
+	delete(idx.entries, key)
+	for i, img := range e.meta.GetImages() {
+		delete(idx.images[img.GetHash()], e.imagePaths[i])
+		if len(idx.images[img.GetHash()]) == 0 {
+			delete(idx.images, img.GetHash())
+		}
+	}
+	if uid := e.meta.GetUid(); idx.uids[uid] == key {
+		delete(idx.uids, uid)
+	}
+}
+
+// image returns the path to an image file with the given hash.
+func (idx *archiveIndex) image(hash string) (string, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	for fpath := range idx.images[hash] {
+		return fpath, true
+	}
+	return "", false
+}
+
+// uid returns the path of the entry with the given uid.
+func (idx *archiveIndex) uid(uid string) ([]string, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	key, ok := idx.uids[uid]
+	if !ok {
+		return nil, false
+	}
+	return strings.Split(key, "/"), true
+}
+
+func (idx *archiveIndex) get(path []string) (*indexEntry, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	e, ok := idx.entries[indexKey(path)]
+	return e, ok
+}
+
+// walk calls fn for every entry below the root in depth-first order, fn must
+// not call any other methods on the index.
+func (idx *archiveIndex) walk(fn func(path []string, e *indexEntry) bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	var visit func(path []string) bool
+	visit = func(path []string) bool {
+		e, ok := idx.entries[indexKey(path)]
+		if !ok {
+			return true
+		}
+		for _, name := range e.items {
+			childPath := append(path[:len(path):len(path)], name)
+			child, ok := idx.entries[indexKey(childPath)]
+			if ok && !fn(childPath, child) {
+				return false
+			}
+		}
+		for _, name := range e.containers {
+			childPath := append(path[:len(path):len(path)], name)
+			child, ok := idx.entries[indexKey(childPath)]
+			if !ok {
+				continue
+			}
+			if !fn(childPath, child) || !visit(childPath) {
+				return false
+			}
+		}
+		return true
+	}
+	visit(nil)
+}
+
+// load reads the entry at path and everything below it into loaded.
+func (idx *archiveIndex) load(path []string, loaded map[string]*indexEntry, dirs *[]string) error {
+	fpath := idx.fpath(path)
+	info, err := os.Stat(fpath)
+	if err != nil {
+		return err
+	}
+	meta, imagePaths, err := readIndexedMeta(fpath)
+	if err != nil {
+		return err
+	}
+	e := &indexEntry{
+		info:        info,
+		meta:        meta,
+		imagePaths:  imagePaths,
+		isContainer: len(path) == 0 || strings.HasSuffix(path[len(path)-1], ".container"),
+	}
+	loaded[indexKey(path)] = e
+	*dirs = append(*dirs, fpath)
+
+	if !e.isContainer {
+		return nil
+	}
+	e.items, e.containers, err = readChildren(fpath)
+	if err != nil {
+		return err
+	}
+	for _, name := range e.items {
+		err = idx.load(append(path[:len(path):len(path)], name), loaded, dirs)
+		if err != nil {
+			return err
+		}
+	}
+	for _, name := range e.containers {
+		err = idx.load(append(path[:len(path):len(path)], name), loaded, dirs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sync makes the index reflect the current state of the entry at path and
+// everything below it, including the entry having been created or removed.
+func (idx *archiveIndex) sync(path []string) error {
+	loaded := make(map[string]*indexEntry)
+	var dirs []string
+
+	info, err := os.Stat(idx.fpath(path))
+	if err == nil && info.IsDir() {
+		err = idx.load(path, loaded, &dirs)
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		// the entry was removed while it was being loaded, a later event will
+		// pick up whatever replaced it
+		loaded = nil
+		dirs = nil
+	} else if err != nil {
+		return fmt.Errorf("sync: %w", err)
+	}
+
+	var removed []string
+	previous := make(map[string]*indexEntry)
+	owners := make(map[string]string)
+
+	idx.mu.Lock()
+	prefix := indexKey(path)
+	for key, e := range idx.entries {
+		if !isWithinKey(key, prefix) {
+			continue
+		}
+		previous[key] = e
+		if _, ok := loaded[key]; !ok {
+			removed = append(removed, filepath.Join(idx.dir, filepath.FromSlash(key)))
+		}
+		if uid := e.meta.GetUid(); uid != "" && idx.uids[uid] == key {
+			owners[uid] = key
+		}
+		idx.remove(key)
+	}
+	idx.putLoaded(loaded, owners)
+	if len(path) > 0 {
+		idx.refreshChildren(path[:len(path)-1])
+	}
+	idx.publishChanges(previous, loaded)
+	idx.mu.Unlock()
+
+	for _, dir := range removed {
+		// watches follow the directory when it is renamed, so they must be
+		// removed explicitly to avoid receiving events under the old path
+		_ = idx.watcher.Remove(dir)
+	}
+	for _, dir := range dirs {
+		err = idx.watcher.Add(dir)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			slog.Warn("failed to watch directory", "dir", dir, "err", err)
+		}
+	}
+	return nil
+}
+
+// putLoaded adds the loaded entries, the caller must hold the write lock.
+// Entries that share a uid are added in a fixed order so the same one always
+// keeps it: the one that had it before, then the one whose sidecar was
+// modified first since a copy is usually written after its original, then
+// the first by key.
+func (idx *archiveIndex) putLoaded(loaded map[string]*indexEntry, owners map[string]string) {
+	shared := make(map[string][]string)
+	for key, e := range loaded {
+		if uid := e.meta.GetUid(); uid != "" {
+			shared[uid] = append(shared[uid], key)
+			continue
+		}
+		idx.put(key, e)
+	}
+	for uid, keys := range shared {
+		if len(keys) > 1 {
+			modified := make(map[string]time.Time, len(keys))
+			for _, key := range keys {
+				info, err := os.Stat(filepath.Join(idx.dir, filepath.FromSlash(key), sidecarFilename))
+				if err == nil {
+					modified[key] = info.ModTime()
+				}
+			}
+			slices.SortFunc(keys, func(a, b string) int {
+				if (a == owners[uid]) != (b == owners[uid]) {
+					if a == owners[uid] {
+						return -1
+					}
+					return 1
+				}
+				if c := modified[a].Compare(modified[b]); c != 0 {
+					return c
+				}
+				return strings.Compare(a, b)
+			})
+		}
+		for _, key := range keys {
+			idx.put(key, loaded[key])
+		}
+	}
+}
+
+// refreshChildren re-reads the children of the container at path, the caller
+// must hold the write lock.
+func (idx *archiveIndex) refreshChildren(path []string) {
+	key := indexKey(path)
+	e, ok := idx.entries[key]
+	if !ok {
+		return
+	}
+	items, containers, err := readChildren(idx.fpath(path))
+	if errors.Is(err, os.ErrNotExist) {
+		// the container itself is being removed, it will be synced separately
+		return
+	}
+	if err != nil {
+		slog.Warn("failed to read children", "path", path, "err", err)
+		return
+	}
+	updated := *e
+	updated.items = items
+	updated.containers = containers
+	idx.put(key, &updated)
+}
+
+// refreshMeta re-reads the metadata of the entry at path without touching
+// anything below it.
+func (idx *archiveIndex) refreshMeta(path []string) {
+	meta, imagePaths, err := readIndexedMeta(idx.fpath(path))
+	if err != nil {
+		slog.Warn("failed to read entry", "path", path, "err", err)
+		return
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	key := indexKey(path)
+	e, ok := idx.entries[key]
+	if !ok {
+		return
+	}
+	updated := *e
+	updated.meta = meta
+	updated.imagePaths = imagePaths
+	idx.put(key, &updated)
+
+	if !proto.Equal(e.meta, meta) || !slices.Equal(e.imagePaths, imagePaths) {
+		idx.events.publish(&v1.WatchResponse{
+			Type:        v1.WatchResponse_UPDATED,
+			Path:        path,
+			IsContainer: e.isContainer,
+		})
+	}
+}
+
+// publishChanges publishes the difference between the previous and current
+// state of a subtree, the caller must hold the write lock.
+func (idx *archiveIndex) publishChanges(previous, current map[string]*indexEntry) {
+	created := make(map[string]*indexEntry)
+	deleted := make(map[string]*indexEntry)
+	for key, e := range current {
+		prev, ok := previous[key]
+		if !ok {
+			created[key] = e
+			continue
+		}
+		if !proto.Equal(prev.meta, e.meta) || !slices.Equal(prev.imagePaths, e.imagePaths) {
+			idx.events.publish(&v1.WatchResponse{
+				Type:        v1.WatchResponse_UPDATED,
+				Path:        splitKey(key),
+				IsContainer: e.isContainer,
+			})
+		}
+	}
+	for key, e := range previous {
+		if _, ok := current[key]; !ok {
+			deleted[key] = e
+		}
+	}
+
+	for _, key := range changeRoots(deleted) {
+		p := &pendingDelete{
+			path:  splitKey(key),
+			entry: deleted[key],
+		}
+		p.timer = time.AfterFunc(moveWindow, func() {
+			idx.mu.Lock()
+			defer idx.mu.Unlock()
+			if idx.removePending(p) {
+				idx.events.publish(&v1.WatchResponse{
+					Type:        v1.WatchResponse_DELETED,
+					Path:        p.path,
+					IsContainer: p.entry.isContainer,
+				})
+			}
+		})
+		idx.pending = append(idx.pending, p)
+	}
+
+	for _, key := range changeRoots(created) {
+		e := created[key]
+		var src *pendingDelete
+		for _, p := range idx.pending {
+			if os.SameFile(p.entry.info, e.info) {
+				src = p
+				break
+			}
+		}
+		if src != nil && idx.removePending(src) {
+			src.timer.Stop()
+			idx.events.publish(&v1.WatchResponse{
+				Type:        v1.WatchResponse_MOVED,
+				Path:        splitKey(key),
+				Src:         src.path,
+				IsContainer: e.isContainer,
+			})
+			continue
+		}
+		idx.events.publish(&v1.WatchResponse{
+			Type:        v1.WatchResponse_CREATED,
+			Path:        splitKey(key),
+			IsContainer: e.isContainer,
+		})
+	}
+}
+
+// removePending removes p from the pending deletes, it returns false if p was
+// already removed, the caller must hold the write lock.
+func (idx *archiveIndex) removePending(p *pendingDelete) bool {
+	for i, candidate := range idx.pending {
+		if candidate == p {
+			idx.pending = append(idx.pending[:i], idx.pending[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (idx *archiveIndex) watch() {
+	for {
+		select {
+		case event, ok := <-idx.watcher.Events:
+			if !ok {
+				return
+			}
+			idx.handleEvent(event)
+		case err, ok := <-idx.watcher.Errors:
+			if !ok {
+				return
+			}
+			slog.Warn("archive watcher error", "err", err)
+		}
+	}
+}
+
+func (idx *archiveIndex) handleEvent(event fsnotify.Event) {
+	rel, err := filepath.Rel(idx.dir, event.Name)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return
+	}
+	segments := strings.Split(filepath.ToSlash(rel), "/")
+
+	var path []string
+	for _, seg := range segments {
+		if strings.HasPrefix(seg, ".") {
+			return
+		}
+		if !isEntryName(seg) {
+			break
+		}
+		path = append(path, seg)
+	}
+
+	slog.Debug("archive changed", "path", rel, "op", event.Op.String())
+
+	if len(path) == len(segments) {
+		err = idx.sync(path)
+		if err != nil {
+			slog.Warn("failed to sync entry", "path", path, "err", err)
+		}
+		return
+	}
+	idx.refreshMeta(path)
+}
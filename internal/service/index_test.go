@@ -0,0 +1,82 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestIndexSharedUID(t *testing.T) {
+	const sidecar = `{"uid": "drill0uid"}`
+	old := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		// entries are created with the shared uid, in order, their
+		// sidecars were modified a day apart unless sameTime is set
+		entries  []string
+		sameTime bool
+		// copied is created after the index was built, the index is
+		// synced again after that
+		copied string
+		want   string
+	}{
+		{"OlderSidecar", []string{"z.item", "a.item"}, false, "", "z.item"},
+		{"SameTime", []string{"b.item", "a.item"}, true, "", "a.item"},
+		{"Nested", []string{"shelf.container/drill.item", "box.container/drill.item"}, false, "", "shelf.container/drill.item"},
+		{"CopiedLater", []string{"drill.item"}, false, "a.item", "drill.item"},
+		{"CopiedLaterNested", []string{"shelf.container/drill.item"}, false, "a.container/drill.item", "shelf.container/drill.item"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// map iteration order is random, so a wrong order would only
+			// show up some of the time
+			for range 20 {
+				dir := filepath.Join(t.TempDir(), "root.container")
+				write := func(name string, modified time.Time) {
+					fpath := filepath.Join(dir, filepath.FromSlash(name))
+					err := os.MkdirAll(fpath, 0777)
+					if err != nil {
+						t.Fatal(err)
+					}
+					err = os.WriteFile(filepath.Join(fpath, sidecarFilename), []byte(sidecar), 0666)
+					if err != nil {
+						t.Fatal(err)
+					}
+					err = os.Chtimes(filepath.Join(fpath, sidecarFilename), modified, modified)
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+				for i, name := range test.entries {
+					modified := old
+					if !test.sameTime {
+						modified = old.AddDate(0, 0, i)
+					}
+					write(name, modified)
+				}
+				idx, err := newArchiveIndex(dir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if test.copied != "" {
+					// the copy looks older so only the previous owner
+					// decides
+					write(test.copied, old.AddDate(-1, 0, 0))
+					err = idx.sync(nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+				path, ok := idx.uid("drill0uid")
+				idx.close()
+				if !ok {
+					t.Fatal("uid is not in the index")
+				}
+				if got := indexKey(path); got != test.want {
+					t.Fatalf("uid belongs to %s, want %s", got, test.want)
+				}
+			}
+		})
+	}
+}
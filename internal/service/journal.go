@@ -0,0 +1,239 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ClientHeader is the request header clients can set to identify themselves
+// in the journal, clients that do not set it are identified by their address.
+const ClientHeader = "X-Archive-Client"
+
+// journalRecord is stored as a single line of .archive/journal.jsonl for every
+// change made through the service.
+type journalRecord struct {
+	ID        string    `json:"id,omitempty"`
+	Time      time.Time `json:"time"`
+	Client    string    `json:"client"`
+	Operation string    `json:"operation"`
+	Before    []string  `json:"before,omitempty"`
+	After     []string  `json:"after,omitempty"`
+	Detail    string    `json:"detail,omitempty"`
+
+	// the fields below are only set for changes that can be undone, see undo.go
+
+	// Action is the operation that was undone or redone by an Undo or Redo
+	// record and Target the id of the record that was undone or redone.
+	Action string `json:"action,omitempty"`
+	Target string `json:"target,omitempty"`
+	// TrashID is the trash entry an entry was moved to or restored from.
+	TrashID string `json:"trash_id,omitempty"`
+	// MetaBefore and MetaAfter are the metadata of an updated entry before
+	// and after the update.
+	MetaBefore *journalMeta `json:"meta_before,omitempty"`
+	MetaAfter  *journalMeta `json:"meta_after,omitempty"`
+}
+
+// journal is an append-only log of the changes made through the service,
+// changes made to the archive directory by other programs are not recorded.
+type journal struct {
+	fpath  string
+	mu     sync.Mutex
+	lastID int64
+}
+
+func newJournal(root string) *journal {
+	return &journal{
+		fpath: filepath.Join(root, stateDir, "journal.jsonl"),
+	}
+}
+
+func clientIdentity(peer connect.Peer, header http.Header) string {
+	if client := header.Get(ClientHeader); client != "" {
+		return client
+	}
+	return peer.Addr
+}
+
+// record adds a change requested by the client that sent a request to the
+// journal, commits it if versioning is enabled and queues it for the webhooks
+// that subscribed to it.
+func (s Service) record(peer connect.Peer, header http.Header, record journalRecord) journalRecord {
+	record.Client = clientIdentity(peer, header)
+	record = s.journal.add(record)
+	if s.versions != nil {
+		s.versions.add(record)
+	}
+	if s.webhooks != nil {
+		var uid string
+		if e, ok := s.index.get(record.After); ok && record.After != nil {
+			uid = e.meta.GetUid()
+		}
+		s.webhooks.enqueue(record, uid)
+	}
+	return record
+}
+
+// add appends a record to the journal and returns it with its id and time
+// set, the change it describes has already been made so failures are only
+// logged.
+func (j *journal) add(record journalRecord) journalRecord {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	record.Time = time.Now()
+	// ids only need to be unique, basing them on the time keeps them short
+	j.lastID = max(record.Time.UnixNano(), j.lastID+1)
+	record.ID = strconv.FormatInt(j.lastID, 36)
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		slog.Warn("failed to encode journal record", "err", err)
+		return record
+	}
+	err = os.MkdirAll(filepath.Dir(j.fpath), 0777)
+	if err != nil {
+		slog.Warn("failed to write journal", "err", err)
+		return record
+	}
+	f, err := os.OpenFile(j.fpath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		slog.Warn("failed to write journal", "err", err)
+		return record
+	}
+	defer f.Close()
+	_, err = f.Write(append(line, '\n'))
+	if err != nil {
+		slog.Warn("failed to write journal", "err", err)
+	}
+	return record
+}
+
+// read returns every record in the journal, oldest first, lines that cannot
+// be decoded are skipped.
+func (j *journal) read() ([]journalRecord, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	f, err := os.Open(j.fpath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("journal.read: %w", err)
+	}
+	defer f.Close()
+
+	var records []journalRecord
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var record journalRecord
+		err = json.Unmarshal(scanner.Bytes(), &record)
+		if err != nil {
+			slog.Warn("skipping invalid journal record", "line", line, "err", err)
+			continue
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("journal.read: %w", err)
+	}
+	return records, nil
+}
+
+// hasPathPrefix reports whether path is prefix or inside of it.
+func hasPathPrefix(path, prefix []string) bool {
+	return len(path) >= len(prefix) && slices.Equal(path[:len(prefix)], prefix)
+}
+
+// history returns the records that affected the entry at path or anything
+// inside it, most recent first. The entry is followed back through moves and
+// renames, of itself or of a container it was in, so the records from before
+// it got its current path are included as well.
+func (j *journal) history(path []string, limit int) ([]journalRecord, error) {
+	records, err := j.read()
+	if err != nil {
+		return nil, fmt.Errorf("journal.history: %w", err)
+	}
+
+	tracked := [][]string{path}
+	var matched []journalRecord
+	for i := len(records) - 1; i >= 0; i-- {
+		record := records[i]
+		relevant := false
+		var previous [][]string
+		for _, p := range tracked {
+			if (record.Before != nil && hasPathPrefix(record.Before, p)) || (record.After != nil && hasPathPrefix(record.After, p)) {
+				relevant = true
+			}
+			moved := record.Before != nil && record.After != nil && !slices.Equal(record.Before, record.After)
+			if moved && hasPathPrefix(p, record.After) {
+				// the tracked entry, or a container it is in, got its path
+				// through this record, earlier records use the old path
+				relevant = true
+				previous = append(previous, append(slices.Clip(record.Before), p[len(record.After):]...))
+			}
+		}
+		for _, p := range previous {
+			if !slices.ContainsFunc(tracked, func(t []string) bool { return slices.Equal(t, p) }) {
+				tracked = append(tracked, p)
+			}
+		}
+		if !relevant {
+			continue
+		}
+		matched = append(matched, record)
+		if limit > 0 && len(matched) >= limit {
+			break
+		}
+	}
+	return matched, nil
+}
+
+func journalEntry(r journalRecord) *v1.JournalEntry {
+	return &v1.JournalEntry{
+		Time:      timestamppb.New(r.Time),
+		Client:    r.Client,
+		Operation: r.Operation,
+		Before:    r.Before,
+		After:     r.After,
+		Detail:    r.Detail,
+		Action:    r.Action,
+	}
+}
+
+func (s Service) History(ctx context.Context, req *connect.Request[v1.HistoryRequest]) (*connect.Response[v1.HistoryResponse], error) {
+	path := req.Msg.GetPath()
+	_, err := s.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	records, err := s.journal.history(path, int(req.Msg.GetLimit()))
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+
+	entries := make([]*v1.JournalEntry, len(records))
+	for i, r := range records {
+		entries[i] = journalEntry(r)
+	}
+	return &connect.Response[v1.HistoryResponse]{
+		Msg: &v1.HistoryResponse{
+			Entries: entries,
+		},
+	}, nil
+}
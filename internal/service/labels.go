@@ -0,0 +1,573 @@
+package service
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"log/slog"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/skip2/go-qrcode"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+/*
+
+Labels are served over plain HTTP so they can be opened and printed from the browser directly, every
+endpoint takes a `format` query parameter that is either `png` (the default) or `svg`.
+
+- `/qr/{uid}` - a QR code of the link to an entry, `size` sets the width of the png in pixels up to
+  maxQRCodeSize
+- `/labels/{uid}` - a label with the QR code, id, tags and primary image of an entry, `paper` picks
+  the size of the label from label_papers
+- `/label-sheets/{uid}` - a page of labels for a container and every entry below it laid out to fit
+  the grid of `paper`, `page` selects the page when they do not fit onto one and `skip` leaves that
+  many labels at the start of the first page empty so partially used sheets can be printed on
+
+*/
+
+const (
+	// QRCodePattern is the http.ServeMux pattern ServeQRCode should be registered with.
+	QRCodePattern = "GET /qr/{uid}"
+	// LabelPattern is the http.ServeMux pattern ServeLabel should be registered with.
+	LabelPattern = "GET /labels/{uid}"
+	// LabelSheetPattern is the http.ServeMux pattern ServeLabelSheet should be registered with.
+	LabelSheetPattern = "GET /label-sheets/{uid}"
+)
+
+// PageCountHeader is set on label sheets to the number of pages needed for
+// every label of the container.
+const PageCountHeader = "X-Page-Count"
+
+// labelDPI is the resolution labels are rendered at as png.
+const labelDPI = 300
+
+// maxQRCodeSize is the largest size a QR code can be requested in, larger
+// pngs would take a lot of memory to render.
+const maxQRCodeSize = 4096
+
+// labelPaper describes a sheet of label paper, all sizes are in millimeters.
+type labelPaper struct {
+	name                    string
+	pageWidth, pageHeight   float64
+	columns, rows           int
+	labelWidth, labelHeight float64
+	marginLeft, marginTop   float64
+	gapX, gapY              float64
+}
+
+const (
+	a4Width, a4Height         = 210, 297
+	letterWidth, letterHeight = 215.9, 279.4
+)
+
+// label_papers are common sheets of label paper, the first one is the default.
+var label_papers = []labelPaper{
+	{"avery-l7160", a4Width, a4Height, 3, 7, 63.5, 38.1, 7.25, 15.15, 2.54, 0},
+	{"avery-l7163", a4Width, a4Height, 2, 7, 99.1, 38.1, 4.65, 15.15, 2.5, 0},
+	{"avery-l7651", a4Width, a4Height, 5, 13, 38.1, 21.2, 4.75, 10.7, 2.5, 0},
+	{"avery-5160", letterWidth, letterHeight, 3, 10, 66.675, 25.4, 4.7625, 12.7, 3.175, 0},
+	{"avery-5163", letterWidth, letterHeight, 2, 5, 101.6, 50.8, 3.96875, 12.7, 3.175, 0},
+}
+
+// label is the content of a single label.
+type label struct {
+	link string
+	id   string
+	tags []string
+	// thumbnail is the path to a thumbnail of the primary image of the entry,
+	// it is empty if the entry does not have an image
+	thumbnail string
+}
+
+// labelCanvas is what labels are drawn onto, coordinates start at the top
+// left and are in the unit of the drawing, millimeters for labels.
+type labelCanvas interface {
+	fillRect(x, y, w, h float64)
+	// drawImage fits the image into the rectangle, keeping its aspect ratio
+	drawImage(x, y, w, h float64, fpath string)
+	// drawText draws a single line of text with its baseline at y
+	drawText(x, y, size float64, bold bool, text string)
+}
+
+var (
+	labelFontsOnce sync.Once
+	labelFonts     map[bool]*opentype.Font
+)
+
+// labelFont returns the font labels are drawn with, text is measured with
+// the same font for svg so it fits as long as the viewer has the Go fonts.
+func labelFont(bold bool) *opentype.Font {
+	labelFontsOnce.Do(func() {
+		regular, err := opentype.Parse(goregular.TTF)
+		if err != nil {
+			panic(fmt.Sprintf("labelFont: %v", err))
+		}
+		boldFont, err := opentype.Parse(gobold.TTF)
+		if err != nil {
+			panic(fmt.Sprintf("labelFont: %v", err))
+		}
+		labelFonts = map[bool]*opentype.Font{false: regular, true: boldFont}
+	})
+	return labelFonts[bold]
+}
+
+// measureFontSize is the size text is measured at, widths are scaled to the
+// actual size afterwards.
+const measureFontSize = 100
+
+// fitText shortens text with an ellipsis until it is at most width wide.
+func fitText(text string, size, width float64, bold bool) string {
+	face, err := opentype.NewFace(labelFont(bold), &opentype.FaceOptions{Size: measureFontSize, DPI: 72})
+	if err != nil {
+		return text
+	}
+	defer face.Close()
+	measure := func(s string) float64 {
+		return float64(font.MeasureString(face, s)) / 64 * size / measureFontSize
+	}
+	if measure(text) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		if s := string(runes) + "…"; measure(s) <= width {
+			return s
+		}
+	}
+	return ""
+}
+
+// drawQRCode draws the QR code of content into a square, including the quiet
+// zone around it.
+func drawQRCode(c labelCanvas, x, y, size float64, content string) error {
+	q, err := qrcode.New(content, qrcode.Medium)
+	if err != nil {
+		return fmt.Errorf("drawQRCode: %w", err)
+	}
+	bitmap := q.Bitmap()
+	module := size / float64(len(bitmap))
+	for row, modules := range bitmap {
+		// draw runs of dark modules as a single rectangle to keep svgs small
+		for col := 0; col < len(modules); col++ {
+			if !modules[col] {
+				continue
+			}
+			end := col
+			for end < len(modules) && modules[end] {
+				end++
+			}
+			c.fillRect(x+float64(col)*module, y+float64(row)*module, float64(end-col)*module, module)
+			col = end
+		}
+	}
+	return nil
+}
+
+// drawLabel lays out a label in the rectangle: the QR code on the left, the
+// id and tags to the right of it and the image in the bottom right corner.
+func drawLabel(c labelCanvas, x, y, w, h float64, l label) error {
+	padding := min(w, h) * 0.06
+	qrSize := min(h, w/2)
+	err := drawQRCode(c, x, y+(h-qrSize)/2, qrSize, l.link)
+	if err != nil {
+		return fmt.Errorf("drawLabel: %w", err)
+	}
+
+	textX := x + qrSize
+	textWidth := w - qrSize - padding
+	idSize := h * 0.16
+	tagSize := h * 0.12
+	c.drawText(textX, y+padding+idSize*0.8, idSize, true, fitText(l.id, idSize, textWidth, true))
+	if len(l.tags) > 0 {
+		tags := "#" + strings.Join(l.tags, " #")
+		c.drawText(textX, y+padding+idSize+tagSize, tagSize, false, fitText(tags, tagSize, textWidth, false))
+	}
+
+	if l.thumbnail != "" {
+		thumbSize := min(h*0.45, textWidth)
+		c.drawImage(x+w-padding-thumbSize, y+h-padding-thumbSize, thumbSize, thumbSize, l.thumbnail)
+	}
+	return nil
+}
+
+type svgCanvas struct {
+	buf bytes.Buffer
+}
+
+func newSVGCanvas(width, height float64, unit string) *svgCanvas {
+	c := &svgCanvas{}
+	fmt.Fprintf(
+		&c.buf,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%g%s" height="%g%s" viewBox="0 0 %g %g">`+"\n",
+		width, unit, height, unit, width, height,
+	)
+	return c
+}
+
+func (c *svgCanvas) fillRect(x, y, w, h float64) {
+	fmt.Fprintf(&c.buf, `<rect x="%.3f" y="%.3f" width="%.3f" height="%.3f"/>`+"\n", x, y, w, h)
+}
+
+func (c *svgCanvas) drawImage(x, y, w, h float64, fpath string) {
+	contents, err := os.ReadFile(fpath)
+	if err != nil {
+		slog.Warn("failed to read label image", "filepath", fpath, "err", err)
+		return
+	}
+	// thumbnails are either cached files or the image itself, so the
+	// extension is the only thing they have in common
+	var mimeType string
+	ext := strings.TrimPrefix(filepath.Ext(fpath), ".")
+	for _, e := range image_extensions {
+		if e.ext == ext {
+			mimeType = image_mime_types[e.format]
+		}
+	}
+	fmt.Fprintf(
+		&c.buf,
+		`<image x="%.3f" y="%.3f" width="%.3f" height="%.3f" preserveAspectRatio="xMidYMid meet" href="data:%s;base64,%s"/>`+"\n",
+		x, y, w, h, mimeType, base64.StdEncoding.EncodeToString(contents),
+	)
+}
+
+func (c *svgCanvas) drawText(x, y, size float64, bold bool, text string) {
+	weight := "normal"
+	if bold {
+		weight = "bold"
+	}
+	fmt.Fprintf(
+		&c.buf,
+		`<text x="%.3f" y="%.3f" font-size="%.3f" font-family="Go, sans-serif" font-weight="%s">`,
+		x, y, size, weight,
+	)
+	_ = xml.EscapeText(&c.buf, []byte(text))
+	c.buf.WriteString("</text>\n")
+}
+
+func (c *svgCanvas) bytes() []byte {
+	c.buf.WriteString("</svg>\n")
+	return c.buf.Bytes()
+}
+
+type pngCanvas struct {
+	img *image.RGBA
+	// scale is the amount of pixels per unit of the drawing
+	scale float64
+}
+
+func newPNGCanvas(width, height, scale float64) *pngCanvas {
+	img := image.NewRGBA(image.Rect(0, 0, int(math.Ceil(width*scale)), int(math.Ceil(height*scale))))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	return &pngCanvas{img: img, scale: scale}
+}
+
+// rect converts a rectangle to pixels, edges are rounded so rectangles that
+// touch still touch afterwards.
+func (c *pngCanvas) rect(x, y, w, h float64) image.Rectangle {
+	return image.Rect(
+		int(math.Round(x*c.scale)),
+		int(math.Round(y*c.scale)),
+		int(math.Round((x+w)*c.scale)),
+		int(math.Round((y+h)*c.scale)),
+	)
+}
+
+func (c *pngCanvas) fillRect(x, y, w, h float64) {
+	draw.Draw(c.img, c.rect(x, y, w, h), image.Black, image.Point{}, draw.Src)
+}
+
+func (c *pngCanvas) drawImage(x, y, w, h float64, fpath string) {
+	ext := strings.TrimPrefix(filepath.Ext(fpath), ".")
+	if ext == "svg" {
+		// svgs cannot be rasterized, the label is printed without the image
+		return
+	}
+	img, err := decodeImage(fpath, ext)
+	if err != nil {
+		slog.Warn("failed to read label image", "filepath", fpath, "err", err)
+		return
+	}
+	bounds := img.Bounds()
+	scale := min(w/float64(bounds.Dx()), h/float64(bounds.Dy()))
+	fitW, fitH := float64(bounds.Dx())*scale, float64(bounds.Dy())*scale
+	dst := c.rect(x+(w-fitW)/2, y+(h-fitH)/2, fitW, fitH)
+	draw.CatmullRom.Scale(c.img, dst, img, bounds, draw.Over, nil)
+}
+
+func (c *pngCanvas) drawText(x, y, size float64, bold bool, text string) {
+	face, err := opentype.NewFace(labelFont(bold), &opentype.FaceOptions{
+		Size:    size * c.scale,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		slog.Warn("failed to create label font", "err", err)
+		return
+	}
+	defer face.Close()
+	d := font.Drawer{
+		Dst:  c.img,
+		Src:  image.NewUniform(color.Black),
+		Face: face,
+		Dot:  fixed.P(int(math.Round(x*c.scale)), int(math.Round(y*c.scale))),
+	}
+	d.DrawString(text)
+}
+
+func (c *pngCanvas) bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	err := png.Encode(&buf, c.img)
+	if err != nil {
+		return nil, fmt.Errorf("pngCanvas.bytes: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// render draws a drawing of the given size in the format requested by the
+// query of r and writes it to w, pixelsPerUnit is the resolution of pngs and
+// svgUnit the unit of the width and height of svgs.
+func render(w http.ResponseWriter, r *http.Request, width, height, pixelsPerUnit float64, svgUnit string, fn func(c labelCanvas) error) {
+	var contents []byte
+	var contentType string
+	switch format := r.URL.Query().Get("format"); format {
+	case "", "png":
+		c := newPNGCanvas(width, height, pixelsPerUnit)
+		err := fn(c)
+		if err == nil {
+			contents, err = c.bytes()
+		}
+		if err != nil {
+			slog.Warn("failed to render label", "url", r.URL, "err", err)
+			http.Error(w, "failed to render label", http.StatusInternalServerError)
+			return
+		}
+		contentType = "image/png"
+	case "svg":
+		c := newSVGCanvas(width, height, svgUnit)
+		err := fn(c)
+		if err != nil {
+			slog.Warn("failed to render label", "url", r.URL, "err", err)
+			http.Error(w, "failed to render label", http.StatusInternalServerError)
+			return
+		}
+		contents = c.bytes()
+		contentType = "image/svg+xml"
+	default:
+		http.Error(w, fmt.Sprintf("unknown format '%s', must be png or svg", format), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	_, _ = w.Write(contents)
+}
+
+// entryLink is the link encoded into the QR code of an entry, it opens the
+// entry in the web interface.
+func (s Service) entryLink(uid string) string {
+	return fmt.Sprintf("%s/#%s%s", strings.TrimSuffix(s.publicURL, "/"), uidPrefix, uid)
+}
+
+// labelFor returns the label of the entry at path.
+func (s Service) labelFor(path []string, e *indexEntry) label {
+	id, tags, _, _ := parseFilename(path[len(path)-1])
+	l := label{
+		link: s.entryLink(e.meta.GetUid()),
+		id:   id,
+		tags: tags,
+	}
+	if images := e.meta.GetImages(); len(images) > 0 {
+		thumbnail, err := s.thumbnails.get(images[0].GetHash(), "medium")
+		if err != nil {
+			slog.Warn("failed to get thumbnail for label", "path", path, "err", err)
+		} else {
+			l.thumbnail = thumbnail
+		}
+	}
+	return l
+}
+
+// labelEntry looks up the entry whose uid is in the url of r, writing an
+// error to w if there is none.
+func (s Service) labelEntry(w http.ResponseWriter, r *http.Request) ([]string, *indexEntry, bool) {
+	path, ok := s.index.uid(r.PathValue("uid"))
+	if !ok {
+		http.NotFound(w, r)
+		return nil, nil, false
+	}
+	e, ok := s.index.get(path)
+	if !ok {
+		http.NotFound(w, r)
+		return nil, nil, false
+	}
+	return path, e, true
+}
+
+// labelPaperFor returns the label paper named by the paper query parameter
+// of r, writing an error to w if there is no such paper.
+func labelPaperFor(w http.ResponseWriter, r *http.Request) (labelPaper, bool) {
+	name := r.URL.Query().Get("paper")
+	if name == "" {
+		return label_papers[0], true
+	}
+	for _, p := range label_papers {
+		if p.name == name {
+			return p, true
+		}
+	}
+	names := make([]string, len(label_papers))
+	for i, p := range label_papers {
+		names[i] = p.name
+	}
+	http.Error(w, fmt.Sprintf("unknown paper '%s', must be one of %s", name, strings.Join(names, ", ")), http.StatusBadRequest)
+	return labelPaper{}, false
+}
+
+// queryInt returns the integer query parameter key of r, or def if it is not
+// set, writing an error to w if it is not an integer of at least least.
+func queryInt(w http.ResponseWriter, r *http.Request, key string, def, least int) (int, bool) {
+	raw := r.URL.Query().Get(key)
+	if raw == "" {
+		return def, true
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < least {
+		http.Error(w, fmt.Sprintf("%s must be an integer of at least %d", key, least), http.StatusBadRequest)
+		return 0, false
+	}
+	return n, true
+}
+
+// ServeQRCode serves the QR code of the link to an entry.
+func (s Service) ServeQRCode(w http.ResponseWriter, r *http.Request) {
+	_, e, ok := s.labelEntry(w, r)
+	if !ok {
+		return
+	}
+	size, ok := queryInt(w, r, "size", 512, 21)
+	if !ok {
+		return
+	}
+	if size > maxQRCodeSize {
+		http.Error(w, fmt.Sprintf("size must be at most %d", maxQRCodeSize), http.StatusBadRequest)
+		return
+	}
+	// the drawing is in pixels, svgs have the same size but can be scaled
+	// without losing quality
+	side := float64(size)
+	render(w, r, side, side, 1, "", func(c labelCanvas) error {
+		return drawQRCode(c, 0, 0, side, s.entryLink(e.meta.GetUid()))
+	})
+}
+
+// ServeLabel serves a single label for an entry.
+func (s Service) ServeLabel(w http.ResponseWriter, r *http.Request) {
+	path, e, ok := s.labelEntry(w, r)
+	if !ok {
+		return
+	}
+	paper, ok := labelPaperFor(w, r)
+	if !ok {
+		return
+	}
+	l := s.labelFor(path, e)
+	render(w, r, paper.labelWidth, paper.labelHeight, labelDPI/25.4, "mm", func(c labelCanvas) error {
+		return drawLabel(c, 0, 0, paper.labelWidth, paper.labelHeight, l)
+	})
+}
+
+// ServeLabelSheet serves a page of labels for a container and every entry
+// below it.
+func (s Service) ServeLabelSheet(w http.ResponseWriter, r *http.Request) {
+	path, e, ok := s.labelEntry(w, r)
+	if !ok {
+		return
+	}
+	if !e.isContainer {
+		http.Error(w, "label sheets can only be made for containers", http.StatusBadRequest)
+		return
+	}
+	paper, ok := labelPaperFor(w, r)
+	if !ok {
+		return
+	}
+	page, ok := queryInt(w, r, "page", 1, 1)
+	if !ok {
+		return
+	}
+	perPage := paper.columns * paper.rows
+	skip, ok := queryInt(w, r, "skip", 0, 0)
+	if !ok {
+		return
+	}
+	if skip >= perPage {
+		http.Error(w, fmt.Sprintf("skip must be less than the %d labels on a page", perPage), http.StatusBadRequest)
+		return
+	}
+
+	type entry struct {
+		path []string
+		e    *indexEntry
+	}
+	entries := []entry{{path, e}}
+	prefix := indexKey(path)
+	s.index.walk(func(p []string, child *indexEntry) bool {
+		// entries get their uid in the background, until then there is
+		// nothing to link to
+		if child.meta.GetUid() == "" {
+			return true
+		}
+		if key := indexKey(p); key != prefix && isWithinKey(key, prefix) {
+			entries = append(entries, entry{slices.Clone(p), child})
+		}
+		return true
+	})
+
+	positions := skip + len(entries)
+	pages := (positions + perPage - 1) / perPage
+	if page > pages {
+		http.NotFound(w, r)
+		return
+	}
+	// the first page starts with skip empty slots
+	first := (page-1)*perPage - skip
+	empty := max(-first, 0)
+	onPage := entries[first+empty : min(first+perPage, len(entries))]
+	// thumbnails are looked up after walking since they need the index, and
+	// only for the labels on the page
+	labels := make([]label, len(onPage))
+	for i, entry := range onPage {
+		labels[i] = s.labelFor(entry.path, entry.e)
+	}
+	w.Header().Set(PageCountHeader, strconv.Itoa(pages))
+	render(w, r, paper.pageWidth, paper.pageHeight, labelDPI/25.4, "mm", func(c labelCanvas) error {
+		for i, l := range labels {
+			slot := empty + i
+			col, row := slot%paper.columns, slot/paper.columns
+			x := paper.marginLeft + float64(col)*(paper.labelWidth+paper.gapX)
+			y := paper.marginTop + float64(row)*(paper.labelHeight+paper.gapY)
+			err := drawLabel(c, x, y, paper.labelWidth, paper.labelHeight, l)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
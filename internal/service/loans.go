@@ -0,0 +1,219 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// loan is stored in the loan of meta.json while an item is checked out.
+type loan struct {
+	Borrower string    `json:"borrower"`
+	Since    time.Time `json:"since"`
+	Due      string    `json:"due,omitempty"`
+	Note     string    `json:"note,omitempty"`
+}
+
+func (l *loan) proto() *v1.Loan {
+	if l == nil {
+		return nil
+	}
+	return &v1.Loan{
+		Borrower: l.Borrower,
+		Since:    timestamppb.New(l.Since),
+		Due:      l.Due,
+		Note:     l.Note,
+	}
+}
+
+// today is the current local date, formatted like due dates.
+func today() string {
+	return time.Now().Format(dateLayout)
+}
+
+// loanOverdue reports whether the due date of l is before today, loans
+// without a due date are never overdue.
+func loanOverdue(l *v1.Loan, today string) bool {
+	// dates are formatted as YYYY-MM-DD so they sort alphabetically
+	return l.GetDue() != "" && l.GetDue() < today
+}
+
+// resolveItem resolves path and checks that it points to an existing item.
+func (s Service) resolveItem(op string, path []string) (string, error) {
+	fpath, err := s.resolveEntry(path)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasSuffix(path[len(path)-1], ".item") {
+		return "", entryError(
+			connect.CodeInvalidArgument,
+			v1.EntryError_INVALID_PATH,
+			path,
+			fmt.Errorf("%s: only items can be checked out", op),
+		)
+	}
+	_, err = os.Stat(fpath)
+	if err != nil {
+		return "", fsError(path, err)
+	}
+	return fpath, nil
+}
+
+func (s Service) CheckOut(ctx context.Context, req *connect.Request[v1.CheckOutRequest]) (*connect.Response[v1.CheckOutResponse], error) {
+	path := req.Msg.GetPath()
+	borrower := strings.TrimSpace(req.Msg.GetBorrower())
+	due := req.Msg.GetDue()
+	if borrower == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("CheckOut: borrower cannot be empty"))
+	}
+	if due != "" {
+		_, err := time.Parse(dateLayout, due)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("CheckOut: due must be formatted as YYYY-MM-DD: %w", err))
+		}
+	}
+	fpath, err := s.resolveItem("CheckOut", path)
+	if err != nil {
+		return nil, err
+	}
+
+	s.sidecars.Lock()
+	defer s.sidecars.Unlock()
+
+	sc, err := readSidecar(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	if sc.Loan != nil {
+		return nil, entryError(
+			connect.CodeFailedPrecondition,
+			v1.EntryError_CHECKED_OUT,
+			path,
+			fmt.Errorf("CheckOut: %v is already checked out by %s", path, sc.Loan.Borrower),
+		)
+	}
+	sc.Loan = &loan{
+		Borrower: borrower,
+		Since:    time.Now(),
+		Due:      due,
+		Note:     req.Msg.GetNote(),
+	}
+	err = writeSidecar(fpath, sc)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	s.syncIndex(path)
+	detail := borrower
+	if due != "" {
+		detail += ", due " + due
+	}
+	s.record(req.Peer(), req.Header(), journalRecord{
+		Operation: "CheckOut",
+		Before:    path,
+		After:     path,
+		Detail:    detail,
+	})
+
+	return &connect.Response[v1.CheckOutResponse]{
+		Msg: &v1.CheckOutResponse{
+			Loan: sc.Loan.proto(),
+		},
+	}, nil
+}
+
+func (s Service) CheckIn(ctx context.Context, req *connect.Request[v1.CheckInRequest]) (*connect.Response[v1.CheckInResponse], error) {
+	path := req.Msg.GetPath()
+	fpath, err := s.resolveItem("CheckIn", path)
+	if err != nil {
+		return nil, err
+	}
+
+	s.sidecars.Lock()
+	defer s.sidecars.Unlock()
+
+	sc, err := readSidecar(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	if sc.Loan == nil {
+		return nil, entryError(
+			connect.CodeFailedPrecondition,
+			v1.EntryError_NOT_CHECKED_OUT,
+			path,
+			fmt.Errorf("CheckIn: %v is not checked out", path),
+		)
+	}
+	ended := sc.Loan
+	sc.Loan = nil
+	err = writeSidecar(fpath, sc)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	s.syncIndex(path)
+	s.record(req.Peer(), req.Header(), journalRecord{
+		Operation: "CheckIn",
+		Before:    path,
+		After:     path,
+		Detail:    ended.Borrower,
+	})
+
+	return &connect.Response[v1.CheckInResponse]{
+		Msg: &v1.CheckInResponse{
+			Loan: ended.proto(),
+		},
+	}, nil
+}
+
+func (s Service) ListCheckedOut(ctx context.Context, req *connect.Request[v1.ListCheckedOutRequest]) (*connect.Response[v1.ListCheckedOutResponse], error) {
+	scope := req.Msg.GetPath()
+	_, err := s.resolve(scope)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.index.get(scope); !ok {
+		return nil, fsError(scope, &os.PathError{Op: "read", Path: s.index.fpath(scope), Err: os.ErrNotExist})
+	}
+
+	prefix := indexKey(scope)
+	now := today()
+	var entries []*v1.ListCheckedOutResponse_Entry
+	s.index.walk(func(path []string, e *indexEntry) bool {
+		if !isWithinKey(indexKey(path), prefix) || e.meta.GetLoan() == nil {
+			return true
+		}
+		overdue := loanOverdue(e.meta.GetLoan(), now)
+		if req.Msg.GetOverdueOnly() && !overdue {
+			return true
+		}
+		entries = append(entries, &v1.ListCheckedOutResponse_Entry{
+			Path:    path,
+			Meta:    e.metadata(),
+			Overdue: overdue,
+		})
+		return true
+	})
+	slices.SortStableFunc(entries, func(a, b *v1.ListCheckedOutResponse_Entry) int {
+		aDue, bDue := a.GetMeta().GetLoan().GetDue(), b.GetMeta().GetLoan().GetDue()
+		if (aDue == "") != (bDue == "") {
+			if aDue == "" {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Compare(aDue, bDue)
+	})
+
+	return &connect.Response[v1.ListCheckedOutResponse]{
+		Msg: &v1.ListCheckedOutResponse{
+			Entries: entries,
+		},
+	}, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	v1 "item-archived/api/v1"
+	"regexp"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/makiuchi-d/gozxing"
+	multiqrcode "github.com/makiuchi-d/gozxing/multi/qrcode"
+	"github.com/makiuchi-d/gozxing/oned"
+	"github.com/makiuchi-d/gozxing/qrcode"
+)
+
+// uidPattern matches the uids made by newUID.
+var uidPattern = regexp.MustCompile(`^[a-z2-7]{16}$`)
+
+// parseLabelCode returns the uid in the code of a label, codes hold either
+// the link made by entryLink or just the uid, with or without the @ prefix.
+func parseLabelCode(code string) (string, bool) {
+	code = strings.TrimSpace(code)
+	if i := strings.LastIndex(code, "#"+uidPrefix); i >= 0 {
+		code = code[i+len("#"+uidPrefix):]
+	}
+	uid := strings.ToLower(strings.TrimPrefix(code, uidPrefix))
+	return uid, uidPattern.MatchString(uid)
+}
+
+// decodeLabelCodes returns the text of every QR code and Code128 barcode
+// found in img, QR codes first.
+func decodeLabelCodes(img image.Image) ([]string, error) {
+	bmp, err := gozxing.NewBinaryBitmapFromImage(img)
+	if err != nil {
+		return nil, fmt.Errorf("decodeLabelCodes: %w", err)
+	}
+	hints := map[gozxing.DecodeHintType]interface{}{
+		gozxing.DecodeHintType_TRY_HARDER: true,
+	}
+
+	// the readers return an error when they find nothing, which is expected
+	// as a photo usually has only one kind of code on it
+	var codes []string
+	results, err := multiqrcode.NewQRCodeMultiReader().DecodeMultiple(bmp, hints)
+	if err == nil {
+		for _, r := range results {
+			codes = append(codes, r.GetText())
+		}
+	}
+	if len(codes) == 0 {
+		// the multi reader misses some codes the plain reader finds
+		result, err := qrcode.NewQRCodeReader().Decode(bmp, hints)
+		if err == nil {
+			codes = append(codes, result.GetText())
+		}
+	}
+	result, err := oned.NewCode128Reader().Decode(bmp, hints)
+	if err == nil {
+		codes = append(codes, result.GetText())
+	}
+	return codes, nil
+}
+
+func (s Service) Locate(ctx context.Context, req *connect.Request[v1.LocateRequest]) (*connect.Response[v1.LocateResponse], error) {
+	format := req.Msg.GetFormat()
+	if format == v1.ImageFormat_SVG {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Locate: svg images are not supported"))
+	}
+	if imageExtension(format) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Locate: unknown image format %v", format))
+	}
+	// a small upload can hold a huge image, so its size is checked before
+	// decoding it like in decodeImage
+	config, _, err := image.DecodeConfig(bytes.NewReader(req.Msg.GetImage()))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Locate: %w", err))
+	}
+	if int64(config.Width)*int64(config.Height) > maxDecodedPixels {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Locate: %w: %dx%d", errImageTooLarge, config.Width, config.Height))
+	}
+	img, _, err := image.Decode(bytes.NewReader(req.Msg.GetImage()))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Locate: %w", err))
+	}
+
+	codes, err := decodeLabelCodes(img)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Locate: %w", err))
+	}
+	if len(codes) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Locate: no QR code or barcode was found in the image"))
+	}
+	for _, code := range codes {
+		uid, ok := parseLabelCode(code)
+		if !ok {
+			continue
+		}
+		path, ok := s.index.uid(uid)
+		if !ok {
+			continue
+		}
+		entry, err := s.readEntry(path)
+		if err != nil {
+			return nil, err
+		}
+		return &connect.Response[v1.LocateResponse]{
+			Msg: &v1.LocateResponse{
+				Code:  code,
+				Path:  path,
+				Entry: entry,
+			},
+		}, nil
+	}
+	return nil, connect.NewError(
+		connect.CodeNotFound,
+		fmt.Errorf("Locate: no entry belongs to the codes in the image: %s", strings.Join(codes, ", ")),
+	)
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	v1 "item-archived/api/v1"
+	"testing"
+
+	"connectrpc.com/connect"
+)
+
+// pngHeader returns the start of a grayscale png of the given size, it is
+// enough for image.DecodeConfig but holds no pixels.
+func pngHeader(width, height uint32) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("\x89PNG\r\n\x1a\n")
+	chunk := binary.BigEndian.AppendUint32(nil, 13)
+	chunk = append(chunk, "IHDR"...)
+	chunk = binary.BigEndian.AppendUint32(chunk, width)
+	chunk = binary.BigEndian.AppendUint32(chunk, height)
+	// 8 bit grayscale, default compression, filter and no interlacing
+	chunk = append(chunk, 8, 0, 0, 0, 0)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+	buf.Write(chunk)
+	return buf.Bytes()
+}
+
+func TestLocateTooLarge(t *testing.T) {
+	s := newTestService(t, nil)
+	_, err := s.Locate(context.Background(), connect.NewRequest(&v1.LocateRequest{
+		Image:  pngHeader(100_000, 100_000),
+		Format: v1.ImageFormat_PNG,
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument || !errors.Is(err, errImageTooLarge) {
+		t.Fatalf("Locate returned %v, want an invalid argument error about the size", err)
+	}
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxMaintenanceHistory is how much maintenance is kept in the maintenance
+// history of an entry, older maintenance is dropped first.
+const maxMaintenanceHistory = 500
+
+// maintenanceRecord is stored in the maintenance_history of meta.json for
+// every LogMaintenance call.
+type maintenanceRecord struct {
+	Time time.Time `json:"time"`
+	Date string    `json:"date"`
+	Note string    `json:"note,omitempty"`
+	Due  string    `json:"due,omitempty"`
+}
+
+// recurrence is the subset of iCalendar recurrence rules that can be used
+// as the maintenance_rule of an entry, like FREQ=MONTHLY;INTERVAL=3.
+type recurrence struct {
+	freq     string
+	interval int
+}
+
+var recurrence_frequencies = []string{"DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+func parseRecurrence(rule string) (recurrence, error) {
+	r := recurrence{interval: 1}
+	rule = strings.ToUpper(strings.TrimSpace(rule))
+	rule = strings.TrimPrefix(rule, "RRULE:")
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return r, fmt.Errorf("parseRecurrence: '%s' is not formatted as KEY=VALUE", part)
+		}
+		switch key {
+		case "FREQ":
+			found := false
+			for _, f := range recurrence_frequencies {
+				found = found || f == value
+			}
+			if !found {
+				return r, fmt.Errorf("parseRecurrence: FREQ must be one of %s, got '%s'", strings.Join(recurrence_frequencies, ", "), value)
+			}
+			r.freq = value
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return r, fmt.Errorf("parseRecurrence: INTERVAL must be a positive number, got '%s'", value)
+			}
+			r.interval = n
+		default:
+			return r, fmt.Errorf("parseRecurrence: %s is not supported", key)
+		}
+	}
+	if r.freq == "" {
+		return r, fmt.Errorf("parseRecurrence: FREQ is missing")
+	}
+	return r, nil
+}
+
+func (r recurrence) String() string {
+	if r.interval == 1 {
+		return "FREQ=" + r.freq
+	}
+	return fmt.Sprintf("FREQ=%s;INTERVAL=%d", r.freq, r.interval)
+}
+
+// addMonths adds n months to t, days past the end of the resulting month are
+// moved back to its last day instead of overflowing into the next one.
+func addMonths(t time.Time, n int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(n), 1, 0, 0, 0, 0, t.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(day, last)-1)
+}
+
+// next returns the date one interval after t.
+func (r recurrence) next(t time.Time) time.Time {
+	switch r.freq {
+	case "DAILY":
+		return t.AddDate(0, 0, r.interval)
+	case "WEEKLY":
+		return t.AddDate(0, 0, 7*r.interval)
+	case "MONTHLY":
+		return addMonths(t, r.interval)
+	}
+	return addMonths(t, 12*r.interval)
+}
+
+// maintenanceRule returns the maintenance_rule of an entry, ok is false if it
+// does not have one.
+func maintenanceRule(fields map[string]*v1.FieldValue) (r recurrence, ok bool) {
+	rule, ok := fields["maintenance_rule"].GetKind().(*v1.FieldValue_Text)
+	if !ok {
+		return recurrence{}, false
+	}
+	r, err := parseRecurrence(rule.Text)
+	return r, err == nil
+}
+
+func (s Service) LogMaintenance(ctx context.Context, req *connect.Request[v1.LogMaintenanceRequest]) (*connect.Response[v1.LogMaintenanceResponse], error) {
+	path := req.Msg.GetPath()
+	date := req.Msg.GetDate()
+	if date == "" {
+		date = today()
+	}
+	done, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("LogMaintenance: date must be formatted as YYYY-MM-DD: %w", err))
+	}
+	fpath, err := s.resolveEntry(path)
+	if err != nil {
+		return nil, err
+	}
+	_, err = os.Stat(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+
+	s.sidecars.Lock()
+	defer s.sidecars.Unlock()
+
+	sc, err := readSidecar(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	due, _ := sc.Fields["next_maintenance"].(string)
+	var next string
+	if raw, ok := sc.Fields["maintenance_rule"]; ok {
+		rule, _ := raw.(string)
+		r, err := parseRecurrence(rule)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("LogMaintenance: %w", err))
+		}
+		next = r.next(done).Format(dateLayout)
+	}
+
+	if sc.Fields == nil {
+		sc.Fields = make(map[string]any)
+	}
+	if next != "" {
+		sc.Fields["next_maintenance"] = next
+	} else {
+		delete(sc.Fields, "next_maintenance")
+	}
+	sc.MaintenanceHistory = append(sc.MaintenanceHistory, maintenanceRecord{
+		Time: time.Now(),
+		Date: date,
+		Note: req.Msg.GetNote(),
+		Due:  due,
+	})
+	if len(sc.MaintenanceHistory) > maxMaintenanceHistory {
+		sc.MaintenanceHistory = sc.MaintenanceHistory[len(sc.MaintenanceHistory)-maxMaintenanceHistory:]
+	}
+	err = writeSidecar(fpath, sc)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	s.syncIndex(path)
+	s.record(req.Peer(), req.Header(), journalRecord{
+		Operation: "LogMaintenance",
+		Before:    path,
+		After:     path,
+		Detail:    strings.TrimSpace(date + " " + req.Msg.GetNote()),
+	})
+
+	return &connect.Response[v1.LogMaintenanceResponse]{
+		Msg: &v1.LogMaintenanceResponse{
+			NextMaintenance: next,
+		},
+	}, nil
+}
+
+func (s Service) MaintenanceHistory(ctx context.Context, req *connect.Request[v1.MaintenanceHistoryRequest]) (*connect.Response[v1.MaintenanceHistoryResponse], error) {
+	path := req.Msg.GetPath()
+	fpath, err := s.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	_, err = os.Stat(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	sc, err := readSidecar(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+
+	records := make([]*v1.MaintenanceRecord, len(sc.MaintenanceHistory))
+	for i, m := range sc.MaintenanceHistory {
+		records[i] = &v1.MaintenanceRecord{
+			Time: timestamppb.New(m.Time),
+			Date: m.Date,
+			Note: m.Note,
+			Due:  m.Due,
+		}
+	}
+	return &connect.Response[v1.MaintenanceHistoryResponse]{
+		Msg: &v1.MaintenanceHistoryResponse{
+			Records: records,
+		},
+	}, nil
+}
+
+func (s Service) DueMaintenance(ctx context.Context, req *connect.Request[v1.DueMaintenanceRequest]) (*connect.Response[v1.DueMaintenanceResponse], error) {
+	scope := req.Msg.GetPath()
+	_, err := s.resolve(scope)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.index.get(scope); !ok {
+		return nil, fsError(scope, &os.PathError{Op: "read", Path: s.index.fpath(scope), Err: os.ErrNotExist})
+	}
+
+	days := int(req.Msg.GetDays())
+	if days == 0 {
+		days = defaultUpcomingDays
+	}
+	to := time.Now().AddDate(0, 0, days).Format(dateLayout)
+
+	return &connect.Response[v1.DueMaintenanceResponse]{
+		Msg: &v1.DueMaintenanceResponse{
+			Entries: s.datedEntries(scope, []string{"next_maintenance"}, "", to),
+		},
+	}, nil
+}
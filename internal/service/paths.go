@@ -0,0 +1,145 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// validateName checks that an id or tag can be used as part of a filename.
+func validateName(kind, name string) error {
+	if name == "" {
+		return fmt.Errorf("%s cannot be empty", kind)
+	}
+	if strings.ContainsAny(name, "./\\\x00") {
+		return fmt.Errorf("%s \"%s\" cannot contain '.', '/', '\\' or NUL characters", kind, name)
+	}
+	return nil
+}
+
+func validateEntryName(id string, tags []string) error {
+	err := validateName("id", id)
+	if err != nil {
+		return err
+	}
+	for _, t := range tags {
+		err = validateName("tag", t)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSegment checks that a path segment follows the
+// `id.tag_1.tag_2.{item,container}` format.
+func validateSegment(segment string) error {
+	if !isEntryName(segment) {
+		return fmt.Errorf("path segment \"%s\" must end in .item or .container", segment)
+	}
+	id, tags, _, err := parseFilename(segment)
+	if err != nil {
+		return err
+	}
+	err = validateEntryName(id, tags)
+	if err != nil {
+		return fmt.Errorf("path segment \"%s\": %w", segment, err)
+	}
+	return nil
+}
+
+// validatePath checks every segment of path, only the last segment of a path
+// may be an item as items cannot hold other entries.
+func validatePath(path []string) error {
+	for i, segment := range path {
+		err := validateSegment(segment)
+		if err != nil {
+			return err
+		}
+		if i < len(path)-1 && !strings.HasSuffix(segment, ".container") {
+			return fmt.Errorf("path segment \"%s\" is an item, it cannot contain other entries", segment)
+		}
+	}
+	return nil
+}
+
+// resolve validates path and returns the location of the entry it refers to
+// on disk, it guarantees that the location is inside the archive, even when
+// part of the path is a symlink.
+func (s Service) resolve(path []string) (string, error) {
+	err := validatePath(path)
+	if err != nil {
+		return "", entryError(
+			connect.CodeInvalidArgument,
+			v1.EntryError_INVALID_PATH,
+			path,
+			fmt.Errorf("resolve: %w", err),
+		)
+	}
+	fpath := filepath.Join(append([]string{s.dir}, path...)...)
+
+	// resolve the deepest part of the path that exists, anything past that
+	// cannot be a symlink yet
+	existing := fpath
+	for {
+		resolved, err := filepath.EvalSymlinks(existing)
+		if err == nil {
+			if !isWithinDir(s.realDir, resolved) {
+				return "", entryError(
+					connect.CodePermissionDenied,
+					v1.EntryError_PERMISSION_DENIED,
+					path,
+					fmt.Errorf("resolve: path %v leads outside of the archive", path),
+				)
+			}
+			break
+		}
+		if !errors.Is(err, os.ErrNotExist) || existing == s.dir {
+			return "", fsError(path, fmt.Errorf("resolve: %w", err))
+		}
+		existing = filepath.Dir(existing)
+	}
+
+	return fpath, nil
+}
+
+// resolveContainer is like resolve but also requires path to point to a
+// container, the root container is represented by an empty path.
+func (s Service) resolveContainer(path []string) (string, error) {
+	if len(path) > 0 && !strings.HasSuffix(path[len(path)-1], ".container") {
+		return "", entryError(
+			connect.CodeInvalidArgument,
+			v1.EntryError_INVALID_PATH,
+			path,
+			fmt.Errorf("resolve: path %v does not point to a container", path),
+		)
+	}
+	return s.resolve(path)
+}
+
+// resolveEntry is like resolve but refuses the root container, for use by
+// operations that cannot be applied to the root.
+func (s Service) resolveEntry(path []string) (string, error) {
+	if len(path) == 0 {
+		return "", entryError(
+			connect.CodePermissionDenied,
+			v1.EntryError_PERMISSION_DENIED,
+			path,
+			fmt.Errorf("resolve: this operation cannot be applied to the root container"),
+		)
+	}
+	return s.resolve(path)
+}
+
+func isWithinDir(dir, fpath string) bool {
+	rel, err := filepath.Rel(dir, fpath)
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+)
+
+func TestValidatePath(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  []string
+		valid bool
+	}{
+		{"Root", nil, true},
+		{"Item", []string{"drill.item"}, true},
+		{"Nested", []string{"garage.container", "shelf.tools.container", "drill.bosch.item"}, true},
+		{"Parent", []string{".."}, false},
+		{"ParentWithType", []string{"...item"}, false},
+		{"ParentInName", []string{"../../etc.container"}, false},
+		{"Slash", []string{"garage/shelf.container"}, false},
+		{"Backslash", []string{`..\..\drill.item`}, false},
+		{"NUL", []string{"drill\x00.item"}, false},
+		{"Current", []string{"."}, false},
+		{"Empty", []string{""}, false},
+		{"EmptyID", []string{".item"}, false},
+		{"EmptyTag", []string{"drill..item"}, false},
+		{"NoType", []string{"drill"}, false},
+		{"OtherType", []string{"drill.txt"}, false},
+		{"InsideItem", []string{"drill.item", "bit.item"}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validatePath(test.path)
+			if valid := err == nil; valid != test.valid {
+				t.Errorf("validatePath(%q) returned %v, valid should be %v", test.path, err, test.valid)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	s := newTestService(t, map[string]string{
+		"garage.container/drill.item/description.txt": "drill",
+	})
+	outside := t.TempDir()
+	err := os.Symlink(outside, filepath.Join(s.dir, "outside.container"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Symlink(filepath.Join(s.dir, "garage.container"), filepath.Join(s.dir, "link.container"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		path []string
+		// code is the code of the error, zero if the path resolves
+		code connect.Code
+	}{
+		{"Root", nil, 0},
+		{"Entry", []string{"garage.container", "drill.item"}, 0},
+		{"Missing", []string{"garage.container", "saw.item"}, 0},
+		{"MissingContainer", []string{"house.container", "saw.item"}, 0},
+		{"InsideSymlink", []string{"link.container", "drill.item"}, 0},
+		{"Traversal", []string{"..", "drill.item"}, connect.CodeInvalidArgument},
+		{"TraversalInName", []string{"../garage.container"}, connect.CodeInvalidArgument},
+		{"OutsideSymlink", []string{"outside.container"}, connect.CodePermissionDenied},
+		{"BelowOutsideSymlink", []string{"outside.container", "drill.item"}, connect.CodePermissionDenied},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fpath, err := s.resolve(test.path)
+			if test.code == 0 {
+				if err != nil {
+					t.Fatal(err)
+				}
+				want := filepath.Join(append([]string{s.dir}, test.path...)...)
+				if fpath != want {
+					t.Errorf("path resolved to %s, want %s", fpath, want)
+				}
+				return
+			}
+			if connect.CodeOf(err) != test.code {
+				t.Errorf("resolve returned %v, want an error with code %v", err, test.code)
+			}
+		})
+	}
+}
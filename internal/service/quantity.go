@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxQuantityHistory is how many changes are kept in the quantity history of
+// an entry, older changes are dropped first.
+const maxQuantityHistory = 500
+
+// quantityChange is stored in the quantity_history of meta.json for every
+// AdjustQuantity call.
+type quantityChange struct {
+	Time     time.Time `json:"time"`
+	Delta    float64   `json:"delta"`
+	Quantity float64   `json:"quantity"`
+	Reason   string    `json:"reason,omitempty"`
+}
+
+// sidecarNumber returns the value of a number field from a sidecar, a field
+// that is not set is reported as not ok.
+func sidecarNumber(sc *sidecar, key string) (value float64, ok bool, err error) {
+	raw, ok := sc.Fields[key]
+	if !ok {
+		return 0, false, nil
+	}
+	value, ok = raw.(float64)
+	if !ok {
+		return 0, false, fmt.Errorf("field \"%s\" is not a number", key)
+	}
+	return value, true, nil
+}
+
+func (s Service) AdjustQuantity(ctx context.Context, req *connect.Request[v1.AdjustQuantityRequest]) (*connect.Response[v1.AdjustQuantityResponse], error) {
+	path := req.Msg.GetPath()
+	delta := req.Msg.GetDelta()
+	if delta == 0 || math.IsNaN(delta) || math.IsInf(delta, 0) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("AdjustQuantity: delta must be a finite number other than zero"))
+	}
+	fpath, err := s.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	_, err = os.Stat(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+
+	s.sidecars.Lock()
+	defer s.sidecars.Unlock()
+
+	sc, err := readSidecar(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	quantity, _, err := sidecarNumber(sc, "quantity")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("AdjustQuantity: %w", err))
+	}
+	quantity += delta
+	if quantity < 0 {
+		return nil, entryError(
+			connect.CodeFailedPrecondition,
+			v1.EntryError_INSUFFICIENT_QUANTITY,
+			path,
+			fmt.Errorf("AdjustQuantity: cannot remove %g, only %g left", -delta, quantity-delta),
+		)
+	}
+
+	if sc.Fields == nil {
+		sc.Fields = make(map[string]any)
+	}
+	sc.Fields["quantity"] = quantity
+	sc.QuantityHistory = append(sc.QuantityHistory, quantityChange{
+		Time:     time.Now(),
+		Delta:    delta,
+		Quantity: quantity,
+		Reason:   req.Msg.GetReason(),
+	})
+	if len(sc.QuantityHistory) > maxQuantityHistory {
+		sc.QuantityHistory = sc.QuantityHistory[len(sc.QuantityHistory)-maxQuantityHistory:]
+	}
+	err = writeSidecar(fpath, sc)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	s.syncIndex(path)
+	s.record(req.Peer(), req.Header(), journalRecord{
+		Operation: "AdjustQuantity",
+		Before:    path,
+		After:     path,
+		Detail:    strings.TrimSpace(fmt.Sprintf("%+g %s", delta, req.Msg.GetReason())),
+	})
+
+	return &connect.Response[v1.AdjustQuantityResponse]{
+		Msg: &v1.AdjustQuantityResponse{
+			Quantity: quantity,
+		},
+	}, nil
+}
+
+func (s Service) QuantityHistory(ctx context.Context, req *connect.Request[v1.QuantityHistoryRequest]) (*connect.Response[v1.QuantityHistoryResponse], error) {
+	path := req.Msg.GetPath()
+	fpath, err := s.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	_, err = os.Stat(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	sc, err := readSidecar(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+
+	changes := make([]*v1.QuantityChange, len(sc.QuantityHistory))
+	for i, c := range sc.QuantityHistory {
+		changes[i] = &v1.QuantityChange{
+			Time:     timestamppb.New(c.Time),
+			Delta:    c.Delta,
+			Quantity: c.Quantity,
+			Reason:   c.Reason,
+		}
+	}
+	return &connect.Response[v1.QuantityHistoryResponse]{
+		Msg: &v1.QuantityHistoryResponse{
+			Changes: changes,
+		},
+	}, nil
+}
+
+func (s Service) LowStock(ctx context.Context, req *connect.Request[v1.LowStockRequest]) (*connect.Response[v1.LowStockResponse], error) {
+	scope := req.Msg.GetPath()
+	_, err := s.resolve(scope)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.index.get(scope); !ok {
+		return nil, fsError(scope, &os.PathError{Op: "read", Path: s.index.fpath(scope), Err: os.ErrNotExist})
+	}
+
+	prefix := indexKey(scope)
+	var entries []*v1.LowStockResponse_Entry
+	s.index.walk(func(path []string, e *indexEntry) bool {
+		if !isWithinKey(indexKey(path), prefix) {
+			return true
+		}
+		fields := e.meta.GetFields()
+		quantity, ok := fields["quantity"].GetKind().(*v1.FieldValue_Number)
+		if !ok {
+			return true
+		}
+		min, ok := fields["min_quantity"].GetKind().(*v1.FieldValue_Number)
+		if !ok || quantity.Number >= min.Number {
+			return true
+		}
+		entries = append(entries, &v1.LowStockResponse_Entry{
+			Path: path,
+			Meta: e.metadata(),
+		})
+		return true
+	})
+
+	return &connect.Response[v1.LowStockResponse]{
+		Msg: &v1.LowStockResponse{
+			Entries: entries,
+		},
+	}, nil
+}
@@ -0,0 +1,486 @@
+package service
+
+import (
+	"cmp"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+/*
+
+Search queries are made up of terms separated by whitespace, terms next to each
+other are implicitly AND-ed together.
+
+- `word` - matches entries whose id or description contains `word`
+- `"some words"` - same as above, but allows whitespace in the term
+- `tag:fruit` - matches entries tagged with `fruit`
+- `id:abc` - matches entries whose id starts with `abc`
+- `id:*abc` - matches entries whose id contains `abc`
+- `desc:word` - matches entries whose description contains `word`
+- `is:item`, `is:container` - matches entries of the given type
+- `status:out`, `status:in`, `status:overdue` - matches items that are checked out, that are not checked
+  out or whose loan is past its due date, see loans.go
+- `in:garage.container` - matches entries somewhere inside a container with the given name,
+  `in:garage` matches by the container's id instead and `in:house.container/garage.container`
+  matches the subtree at that exact path relative to the root
+- `brand:bosch` - matches entries whose `brand` field is `bosch`, this works for every field in
+  meta.json as long as its name is not one of the other search fields
+- `price>50`, `quantity<=2`, `purchase_date>=2024-01-01` - compares the value of a field using one
+  of `=`, `<`, `<=`, `>`, `>=`, numbers and dates are compared by value, text alphabetically
+- `has:serial_number` - matches entries that have the given field
+
+Field names have to start with a letter, other words containing `:`, `<`, `>` or `=` like `12:30`
+are matched as text like `word`.
+
+Terms can be combined with `AND`, `OR`, `NOT` (or a `-` prefix) and grouped with parentheses.
+
+All matching is case-insensitive.
+
+*/
+
+type queryEntry struct {
+	path        []string
+	meta        *v1.EntryMetadata
+	isContainer bool
+}
+
+type queryNode interface {
+	match(e queryEntry) bool
+}
+
+type queryAnd []queryNode
+
+func (q queryAnd) match(e queryEntry) bool {
+	for _, n := range q {
+		if !n.match(e) {
+			return false
+		}
+	}
+	return true
+}
+
+type queryOr []queryNode
+
+func (q queryOr) match(e queryEntry) bool {
+	for _, n := range q {
+		if n.match(e) {
+			return true
+		}
+	}
+	return false
+}
+
+type queryNot struct {
+	node queryNode
+}
+
+func (q queryNot) match(e queryEntry) bool {
+	return !q.node.match(e)
+}
+
+type queryTerm struct {
+	field string
+	value string
+}
+
+func (q queryTerm) match(e queryEntry) bool {
+	id := strings.ToLower(e.meta.GetId())
+	desc := strings.ToLower(e.meta.GetDescription())
+
+	switch q.field {
+	case "":
+		return strings.Contains(id, q.value) || strings.Contains(desc, q.value)
+	case "tag":
+		for _, t := range e.meta.GetTags() {
+			if strings.ToLower(t) == q.value {
+				return true
+			}
+		}
+		return false
+	case "id":
+		if substr, ok := strings.CutPrefix(q.value, "*"); ok {
+			return strings.Contains(id, substr)
+		}
+		return strings.HasPrefix(id, q.value)
+	case "desc":
+		return strings.Contains(desc, q.value)
+	case "is":
+		if q.value == "container" {
+			return e.isContainer
+		}
+		return !e.isContainer
+	case "status":
+		switch q.value {
+		case "out":
+			return e.meta.GetLoan() != nil
+		case "overdue":
+			return loanOverdue(e.meta.GetLoan(), today())
+		}
+		return !e.isContainer && e.meta.GetLoan() == nil
+	case "in":
+		// the last path segment is the entry itself, it should not count as being "in" itself
+		ancestors := e.path[:len(e.path)-1]
+		if strings.Contains(q.value, "/") {
+			scope := strings.Split(q.value, "/")
+			if len(scope) > len(ancestors) {
+				return false
+			}
+			for i, s := range scope {
+				if strings.ToLower(ancestors[i]) != s {
+					return false
+				}
+			}
+			return true
+		}
+		for _, a := range ancestors {
+			a = strings.ToLower(a)
+			if a == q.value {
+				return true
+			}
+			aid, _, _, err := parseFilename(a)
+			if err == nil && aid == q.value {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// queryFieldTerm compares the value of a structured field, op is one of
+// "=", "<", "<=", ">" or ">=".
+type queryFieldTerm struct {
+	key   string
+	op    string
+	value string
+}
+
+func (q queryFieldTerm) match(e queryEntry) bool {
+	field, ok := e.meta.GetFields()[q.key]
+	if !ok {
+		return false
+	}
+
+	var c int
+	switch v := field.GetKind().(type) {
+	case *v1.FieldValue_Text:
+		c = strings.Compare(strings.ToLower(v.Text), q.value)
+	case *v1.FieldValue_Number:
+		n, err := strconv.ParseFloat(q.value, 64)
+		if err != nil {
+			return false
+		}
+		c = cmp.Compare(v.Number, n)
+	case *v1.FieldValue_Date:
+		// dates are formatted as YYYY-MM-DD so they sort alphabetically
+		c = strings.Compare(v.Date, q.value)
+	case *v1.FieldValue_Boolean:
+		return q.op == "=" && strconv.FormatBool(v.Boolean) == q.value
+	default:
+		return false
+	}
+
+	switch q.op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return c == 0
+}
+
+type queryHas struct {
+	key string
+}
+
+func (q queryHas) match(e queryEntry) bool {
+	_, ok := e.meta.GetFields()[q.key]
+	return ok
+}
+
+type queryMatchAll struct{}
+
+func (queryMatchAll) match(e queryEntry) bool {
+	return true
+}
+
+var queryFields = []string{"tag", "id", "desc", "is", "in", "has", "status"}
+
+var queryOperators = []string{"<=", ">=", "<", ">", "="}
+
+type queryTokenKind int
+
+const (
+	tokenTerm queryTokenKind = iota
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+)
+
+type queryToken struct {
+	kind  queryTokenKind
+	value string
+	// quoted terms are never interpreted as keywords or field filters
+	quoted bool
+}
+
+func tokenizeQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, queryToken{kind: tokenLParen})
+			i++
+		case r == ')':
+			tokens = append(tokens, queryToken{kind: tokenRParen})
+			i++
+		case r == '-':
+			tokens = append(tokens, queryToken{kind: tokenNot})
+			i++
+		case r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("tokenizeQuery: unterminated quote at position %d", i)
+			}
+			tokens = append(tokens, queryToken{
+				kind:   tokenTerm,
+				value:  string(runes[i+1 : end]),
+				quoted: true,
+			})
+			i = end + 1
+		default:
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && runes[end] != '(' && runes[end] != ')' {
+				// allow quoted values after a field name: desc:"some words"
+				if runes[end] == '"' {
+					close := end + 1
+					for close < len(runes) && runes[close] != '"' {
+						close++
+					}
+					if close >= len(runes) {
+						return nil, fmt.Errorf("tokenizeQuery: unterminated quote at position %d", end)
+					}
+					end = close
+				}
+				end++
+			}
+			word := string(runes[i:end])
+			i = end
+
+			switch word {
+			case "AND":
+				tokens = append(tokens, queryToken{kind: tokenAnd})
+			case "OR":
+				tokens = append(tokens, queryToken{kind: tokenOr})
+			case "NOT":
+				tokens = append(tokens, queryToken{kind: tokenNot})
+			default:
+				tokens = append(tokens, queryToken{kind: tokenTerm, value: word})
+			}
+		}
+	}
+	return tokens, nil
+}
+
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+func (p *queryParser) peek() (queryToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return queryToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *queryParser) parseOr() (queryNode, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	nodes := queryOr{first}
+	for {
+		tok, ok := p.peek()
+		if !ok || tok.kind != tokenOr {
+			break
+		}
+		p.pos++
+		next, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, next)
+	}
+	if len(nodes) == 1 {
+		return first, nil
+	}
+	return nodes, nil
+}
+
+func (p *queryParser) parseAnd() (queryNode, error) {
+	first, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	nodes := queryAnd{first}
+	for {
+		tok, ok := p.peek()
+		if !ok || tok.kind == tokenOr || tok.kind == tokenRParen {
+			break
+		}
+		if tok.kind == tokenAnd {
+			p.pos++
+		}
+		next, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, next)
+	}
+	if len(nodes) == 1 {
+		return first, nil
+	}
+	return nodes, nil
+}
+
+func (p *queryParser) parseUnary() (queryNode, error) {
+	tok, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of query")
+	}
+	if tok.kind == tokenNot {
+		p.pos++
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return queryNot{node: node}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() (queryNode, error) {
+	tok, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of query")
+	}
+	p.pos++
+
+	switch tok.kind {
+	case tokenLParen:
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		closing, ok := p.peek()
+		if !ok || closing.kind != tokenRParen {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return node, nil
+	case tokenTerm:
+		return parseQueryTerm(tok)
+	}
+	return nil, fmt.Errorf("unexpected token at position %d", p.pos-1)
+}
+
+func parseQueryTerm(tok queryToken) (queryNode, error) {
+	if tok.quoted {
+		return queryTerm{value: strings.ToLower(tok.value)}, nil
+	}
+	i := strings.IndexAny(tok.value, ":<>=")
+	if i < 0 || !isQueryFieldName(strings.ToLower(tok.value[:i])) {
+		return queryTerm{value: strings.ToLower(tok.value)}, nil
+	}
+	if tok.value[i] != ':' {
+		return parseFieldComparison(tok.value[:i], tok.value[i:])
+	}
+
+	field, value := strings.ToLower(tok.value[:i]), tok.value[i+1:]
+	if !slices.Contains(queryFields, field) {
+		return parseFieldComparison(field, "="+value)
+	}
+	value = strings.ToLower(strings.Trim(value, "\""))
+	if value == "" {
+		return nil, fmt.Errorf("field \"%s\" is missing a value", field)
+	}
+	if field == "is" && value != "item" && value != "container" {
+		return nil, fmt.Errorf("is: must be either \"item\" or \"container\", got \"%s\"", value)
+	}
+	if field == "status" && value != "out" && value != "in" && value != "overdue" {
+		return nil, fmt.Errorf("status: must be either \"out\", \"in\" or \"overdue\", got \"%s\"", value)
+	}
+	if field == "has" {
+		return queryHas{key: value}, nil
+	}
+	return queryTerm{field: field, value: value}, nil
+}
+
+// isQueryFieldName reports whether name can be the field of a term, words
+// like `12:30` or `a<b` whose part before the operator is not a field name are
+// searched for as text instead.
+func isQueryFieldName(name string) bool {
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		return false
+	}
+	return slices.Contains(queryFields, name) || validateFieldKey(name) == nil
+}
+
+// parseFieldComparison parses a comparison like `>=50` against the
+// structured field key.
+func parseFieldComparison(key, comparison string) (queryNode, error) {
+	key = strings.ToLower(key)
+	err := validateFieldKey(key)
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range queryOperators {
+		value, ok := strings.CutPrefix(comparison, op)
+		if !ok {
+			continue
+		}
+		value = strings.ToLower(strings.Trim(value, "\""))
+		if value == "" {
+			return nil, fmt.Errorf("field \"%s\" is missing a value", key)
+		}
+		return queryFieldTerm{key: key, op: op, value: value}, nil
+	}
+	return nil, fmt.Errorf("field \"%s\" has an invalid comparison \"%s\"", key, comparison)
+}
+
+func parseQuery(query string) (queryNode, error) {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("parseQuery: %w", err)
+	}
+	if len(tokens) == 0 {
+		return queryMatchAll{}, nil
+	}
+	p := &queryParser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("parseQuery: %w", err)
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("parseQuery: unexpected token at position %d", p.pos)
+	}
+	return node, nil
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	v1 "item-archived/api/v1"
+	"reflect"
+	"testing"
+)
+
+func TestTokenizeQuery(t *testing.T) {
+	term := func(value string) queryToken { return queryToken{kind: tokenTerm, value: value} }
+	tests := []struct {
+		query string
+		want  []queryToken
+	}{
+		{"", nil},
+		{"  drill  ", []queryToken{term("drill")}},
+		{"drill saw", []queryToken{term("drill"), term("saw")}},
+		{`"cordless drill" saw`, []queryToken{{kind: tokenTerm, value: "cordless drill", quoted: true}, term("saw")}},
+		{`desc:"some words"`, []queryToken{term(`desc:"some words"`)}},
+		{"a AND b OR NOT c", []queryToken{term("a"), {kind: tokenAnd}, term("b"), {kind: tokenOr}, {kind: tokenNot}, term("c")}},
+		{"and or not", []queryToken{term("and"), term("or"), term("not")}},
+		{"-(a b)", []queryToken{{kind: tokenNot}, {kind: tokenLParen}, term("a"), term("b"), {kind: tokenRParen}}},
+		{`"AND"`, []queryToken{{kind: tokenTerm, value: "AND", quoted: true}}},
+		{"price>=50", []queryToken{term("price>=50")}},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			got, err := tokenizeQuery(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("tokens are %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestTokenizeQueryUnterminated(t *testing.T) {
+	for _, query := range []string{`"drill`, `desc:"drill`, `saw "drill`} {
+		t.Run(query, func(t *testing.T) {
+			_, err := tokenizeQuery(query)
+			if err == nil {
+				t.Error("tokenizeQuery accepted an unterminated quote")
+			}
+		})
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  queryNode
+	}{
+		{"", queryMatchAll{}},
+		{"Drill", queryTerm{value: "drill"}},
+		{`"Cordless Drill"`, queryTerm{value: "cordless drill"}},
+		{`"tag:fruit"`, queryTerm{value: "tag:fruit"}},
+		{"tag:Fruit", queryTerm{field: "tag", value: "fruit"}},
+		{`desc:"some words"`, queryTerm{field: "desc", value: "some words"}},
+		{"is:container", queryTerm{field: "is", value: "container"}},
+		{"status:overdue", queryTerm{field: "status", value: "overdue"}},
+		{"in:house.container/garage.container", queryTerm{field: "in", value: "house.container/garage.container"}},
+		{"has:serial_number", queryHas{key: "serial_number"}},
+		{"brand:Bosch", queryFieldTerm{key: "brand", op: "=", value: "bosch"}},
+		{"price>50", queryFieldTerm{key: "price", op: ">", value: "50"}},
+		{"quantity<=2", queryFieldTerm{key: "quantity", op: "<=", value: "2"}},
+		{"purchase_date>=2024-01-01", queryFieldTerm{key: "purchase_date", op: ">=", value: "2024-01-01"}},
+		{"size=10", queryFieldTerm{key: "size", op: "=", value: "10"}},
+		{"12:30", queryTerm{value: "12:30"}},
+		{"3<4", queryTerm{value: "3<4"}},
+		{"a b", queryAnd{queryTerm{value: "a"}, queryTerm{value: "b"}}},
+		{"a AND b", queryAnd{queryTerm{value: "a"}, queryTerm{value: "b"}}},
+		{"a OR b c", queryOr{queryTerm{value: "a"}, queryAnd{queryTerm{value: "b"}, queryTerm{value: "c"}}}},
+		{"(a OR b) c", queryAnd{queryOr{queryTerm{value: "a"}, queryTerm{value: "b"}}, queryTerm{value: "c"}}},
+		{"-a", queryNot{node: queryTerm{value: "a"}}},
+		{"NOT NOT a", queryNot{node: queryNot{node: queryTerm{value: "a"}}}},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			got, err := parseQuery(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("query is %#v, want %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseQueryInvalid(t *testing.T) {
+	for _, query := range []string{
+		"tag:",
+		"is:fruit",
+		"status:lost",
+		"price>",
+		"(a b",
+		"a)",
+		"a OR",
+		"NOT",
+		`"a`,
+	} {
+		t.Run(query, func(t *testing.T) {
+			node, err := parseQuery(query)
+			if err == nil {
+				t.Errorf("parseQuery accepted the query as %#v", node)
+			}
+		})
+	}
+}
+
+func TestQueryMatch(t *testing.T) {
+	description := "Cordless, 18V"
+	entries := map[string]queryEntry{
+		"drill": {
+			path: []string{"garage.container", "shelf.tools.container", "drill.bosch.item"},
+			meta: &v1.EntryMetadata{
+				Id:          "drill",
+				Tags:        []string{"bosch"},
+				Description: &description,
+				Fields: map[string]*v1.FieldValue{
+					"price":     {Kind: &v1.FieldValue_Number{Number: 99}},
+					"purchased": {Kind: &v1.FieldValue_Date{Date: "2024-03-01"}},
+					"brand":     {Kind: &v1.FieldValue_Text{Text: "Bosch"}},
+				},
+			},
+		},
+		"shelf": {
+			path:        []string{"garage.container", "shelf.tools.container"},
+			meta:        &v1.EntryMetadata{Id: "shelf", Tags: []string{"tools"}},
+			isContainer: true,
+		},
+	}
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"", []string{"drill", "shelf"}},
+		{"18v", []string{"drill"}},
+		{"tag:tools", []string{"shelf"}},
+		{"id:*ril", []string{"drill"}},
+		{"id:ril", nil},
+		{"is:container", []string{"shelf"}},
+		{"in:garage", []string{"drill", "shelf"}},
+		{"in:shelf.tools.container", []string{"drill"}},
+		{"in:garage.container/shelf.tools.container", []string{"drill"}},
+		{"price>50", []string{"drill"}},
+		{"price>100", nil},
+		{"purchased<2024-06-01", []string{"drill"}},
+		{"brand:bosch", []string{"drill"}},
+		{"has:price", []string{"drill"}},
+		{"-has:price", []string{"shelf"}},
+		{"drill OR tag:tools", []string{"drill", "shelf"}},
+		{"drill tag:tools", nil},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			node, err := parseQuery(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, name := range []string{"drill", "shelf"} {
+				if node.match(entries[name]) {
+					got = append(got, name)
+				}
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("query matches %q, want %q", got, test.want)
+			}
+		})
+	}
+}
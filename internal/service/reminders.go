@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"item-archived/internal/notifier"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultReminderInterval is how often reminders are checked when no
+// interval is given.
+const defaultReminderInterval = time.Hour
+
+// defaultReminderDays is how many days before a deadline it is reminded of,
+// and for how many days after it passed, when no number of days is given.
+const defaultReminderDays = 7
+
+// notifyTimeout is how long a notifier gets to deliver a single reminder.
+const notifyTimeout = 30 * time.Second
+
+// reminderRule produces a notification for everything it currently wants to
+// remind of, the key of a notification identifies what it is about so it is
+// only sent once. Keys are based on uids so they do not change when an entry
+// is moved, entries are skipped until they were assigned a uid.
+type reminderRule struct {
+	name     string
+	evaluate func(s Service, now time.Time) []notifier.Notification
+}
+
+// reminder_rules are evaluated every time reminders are checked.
+var reminder_rules = []reminderRule{
+	{"deadline", Service.deadlineReminders},
+	{"low_stock", Service.lowStockReminders},
+	{"overdue_loan", Service.overdueLoanReminders},
+}
+
+// reminders sends notifications for the reminder_rules, what was sent is
+// stored in .archive/reminders.json. A notification is sent once through
+// every notifier while its rule keeps producing its key, and again if the key
+// goes away and comes back, like when an item runs low on stock a second
+// time. Notifiers that failed are retried the next time reminders are
+// checked.
+type reminders struct {
+	path      string
+	interval  time.Duration
+	days      int
+	notifiers []notifier.Notifier
+	// names identify the notifiers in the sent reminders
+	names []string
+}
+
+func newReminders(root string, opts Options) *reminders {
+	r := &reminders{
+		path:      filepath.Join(root, stateDir, "reminders.json"),
+		interval:  opts.ReminderInterval,
+		days:      opts.ReminderDays,
+		notifiers: opts.Notifiers,
+	}
+	if r.interval <= 0 {
+		r.interval = defaultReminderInterval
+	}
+	if r.days <= 0 {
+		r.days = defaultReminderDays
+	}
+	r.names = make([]string, len(r.notifiers))
+	for i, sink := range r.notifiers {
+		// notifiers that do not name themselves are told apart by their
+		// position
+		if named, ok := sink.(fmt.Stringer); ok {
+			r.names[i] = named.String()
+		} else {
+			r.names[i] = strconv.Itoa(i)
+		}
+	}
+	return r
+}
+
+// sentReminders maps the name of every rule to the keys it sent a notification for, and
+// those to the names of the notifiers that delivered it and when.
+type sentReminders map[string]map[string]map[string]time.Time
+
+func (r *reminders) read() (sentReminders, error) {
+	sent := make(sentReminders)
+	contents, err := os.ReadFile(r.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return sent, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reminders.read: %w", err)
+	}
+	err = json.Unmarshal(contents, &sent)
+	if err != nil {
+		return nil, fmt.Errorf("reminders.read: %w", err)
+	}
+	return sent, nil
+}
+
+func (r *reminders) write(sent sentReminders) error {
+	contents, err := json.Marshal(sent)
+	if err != nil {
+		return fmt.Errorf("reminders.write: %w", err)
+	}
+	err = os.MkdirAll(filepath.Dir(r.path), 0777)
+	if err != nil {
+		return fmt.Errorf("reminders.write: %w", err)
+	}
+	err = os.WriteFile(r.path, contents, 0600)
+	if err != nil {
+		return fmt.Errorf("reminders.write: %w", err)
+	}
+	return nil
+}
+
+// deliver sends n through every notifier that is not in sent yet, and
+// returns the notifiers that have delivered it now. Notifiers that are no
+// longer configured are left out.
+func (r *reminders) deliver(n notifier.Notification, sent map[string]time.Time) map[string]time.Time {
+	delivered := make(map[string]time.Time)
+	for i, sink := range r.notifiers {
+		name := r.names[i]
+		if at, ok := sent[name]; ok {
+			delivered[name] = at
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		err := sink.Notify(ctx, n)
+		cancel()
+		if err != nil {
+			slog.Warn("failed to send reminder", "rule", n.Rule, "key", n.Key, "notifier", name, "err", err)
+			continue
+		}
+		slog.Debug("sent reminder", "rule", n.Rule, "key", n.Key, "notifier", name)
+		delivered[name] = n.Time
+	}
+	return delivered
+}
+
+// remind evaluates every rule and sends the notifications that were not sent
+// yet.
+func (s Service) remind() {
+	sent, err := s.reminders.read()
+	if err != nil {
+		slog.Warn("failed to read sent reminders, reminders may be sent again", "err", err)
+		sent = make(sentReminders)
+	}
+	now := time.Now()
+	for _, rule := range reminder_rules {
+		previous := sent[rule.name]
+		current := make(map[string]map[string]time.Time)
+		for _, n := range rule.evaluate(s, now) {
+			n.Rule = rule.name
+			n.Time = now
+			if delivered := s.reminders.deliver(n, previous[n.Key]); len(delivered) > 0 {
+				current[n.Key] = delivered
+			}
+		}
+		// keys the rule no longer produces are forgotten so they are reminded
+		// of again if they come back
+		sent[rule.name] = current
+	}
+	err = s.reminders.write(sent)
+	if err != nil {
+		slog.Warn("failed to store sent reminders", "err", err)
+	}
+}
+
+// remindPeriodically checks reminders until stop is closed.
+func (s Service) remindPeriodically(stop <-chan struct{}) {
+	s.remind()
+	ticker := time.NewTicker(s.reminders.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			s.remind()
+		}
+	}
+}
+
+// deadlineReminders reminds of every deadline in the next days, and of those
+// that passed in the last days without being dealt with. Older deadlines are
+// left alone, they were either reminded of already or long forgotten.
+func (s Service) deadlineReminders(now time.Time) []notifier.Notification {
+	from := now.AddDate(0, 0, -s.reminders.days).Format(dateLayout)
+	to := now.AddDate(0, 0, s.reminders.days).Format(dateLayout)
+	var notifications []notifier.Notification
+	for _, e := range s.datedEntries(nil, deadlineKeys(), from, to) {
+		uid := e.GetMeta().GetUid()
+		if uid == "" {
+			continue
+		}
+		summary := deadlineSummary(e.GetField(), e.GetMeta().GetId())
+		var when string
+		switch days := e.GetDaysLeft(); {
+		case days == 0:
+			when = "today"
+		case days == 1:
+			when = "tomorrow"
+		case days > 1:
+			when = fmt.Sprintf("in %d days", days)
+		case days == -1:
+			when = "yesterday"
+		default:
+			when = fmt.Sprintf("%d days ago", -days)
+		}
+		notifications = append(notifications, notifier.Notification{
+			// the date is part of the key so a new date is reminded of again
+			Key:     fmt.Sprintf("%s/%s/%s", uid, e.GetField(), e.GetDate()),
+			Subject: fmt.Sprintf("%s %s", summary, when),
+			Body:    fmt.Sprintf("%s on %s.\n\n%s", summary, e.GetDate(), strings.Join(e.GetPath(), " / ")),
+			Path:    e.GetPath(),
+			Link:    s.entryLink(uid),
+		})
+	}
+	return notifications
+}
+
+// lowStockReminders reminds of every entry whose quantity is below its
+// min_quantity, like LowStock.
+func (s Service) lowStockReminders(now time.Time) []notifier.Notification {
+	var notifications []notifier.Notification
+	s.index.walk(func(path []string, e *indexEntry) bool {
+		fields := e.meta.GetFields()
+		quantity, ok := fields["quantity"].GetKind().(*v1.FieldValue_Number)
+		if !ok {
+			return true
+		}
+		min, ok := fields["min_quantity"].GetKind().(*v1.FieldValue_Number)
+		if !ok || quantity.Number >= min.Number || e.meta.GetUid() == "" {
+			return true
+		}
+		id := e.meta.GetId()
+		notifications = append(notifications, notifier.Notification{
+			Key:     e.meta.GetUid(),
+			Subject: fmt.Sprintf("%s is running low", id),
+			Body: fmt.Sprintf(
+				"Only %s of %s are left, the minimum is %s.\n\n%s",
+				strconv.FormatFloat(quantity.Number, 'f', -1, 64),
+				id,
+				strconv.FormatFloat(min.Number, 'f', -1, 64),
+				strings.Join(path, " / "),
+			),
+			Path: path,
+			Link: s.entryLink(e.meta.GetUid()),
+		})
+		return true
+	})
+	return notifications
+}
+
+// overdueLoanReminders reminds of every item that was not returned by its
+// due date.
+func (s Service) overdueLoanReminders(now time.Time) []notifier.Notification {
+	date := now.Format(dateLayout)
+	var notifications []notifier.Notification
+	s.index.walk(func(path []string, e *indexEntry) bool {
+		l := e.meta.GetLoan()
+		if l == nil || !loanOverdue(l, date) || e.meta.GetUid() == "" {
+			return true
+		}
+		id := e.meta.GetId()
+		notifications = append(notifications, notifier.Notification{
+			// every loan is reminded of once, even if the item is lent again
+			Key:     fmt.Sprintf("%s/%d", e.meta.GetUid(), l.GetSince().AsTime().Unix()),
+			Subject: fmt.Sprintf("%s is overdue from %s", id, l.GetBorrower()),
+			Body: fmt.Sprintf(
+				"%s was lent to %s and was due back on %s.\n\n%s",
+				id,
+				l.GetBorrower(),
+				l.GetDue(),
+				strings.Join(path, " / "),
+			),
+			Path: path,
+			Link: s.entryLink(e.meta.GetUid()),
+		})
+		return true
+	})
+	return notifications
+}
@@ -2,12 +2,19 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	v1 "item-archived/api/v1"
+	"item-archived/internal/notifier"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"connectrpc.com/connect"
 )
@@ -18,27 +25,100 @@ All directories/files should be lowercase.
 
 A directory ending in `.item` represents a single item.
 
-- `image.{jpg,png,gif,svg}` - an image of the item
+- `image.{jpg,png,gif,svg}` - the primary image of the item
+- `image-1.{jpg,png,gif,svg}`, `image-2.{jpg,png,gif,svg}`, ... - further images of the item, in order
 - `description.txt` - a description of the item
+- `meta.json` - structured fields of the item like its price or brand, see fields.go, and its uid, see uids.go
+- `attachments/*` - files attached to the item, like manuals, receipts or warranties
 
 A directory ending in `.container` represents a container that contains multiple items.
 
 - `*.item` - items inside this container
 - `*.container` - other containers inside this container
-- `image.{jpg,png,gif,svg}` - an image of the container
+- `image.{jpg,png,gif,svg}` - the primary image of the container
+- `image-1.{jpg,png,gif,svg}`, `image-2.{jpg,png,gif,svg}`, ... - further images of the container, in order
 - `description.txt` - a description of the container
+- `meta.json` - structured fields and the uid of the container
+- `attachments/*` - files attached to the container
 
 You can add tags to a item or container by adding more extensions to the filename like this: `some_cool_thing.multiple.fruit.item`.
 
 In this case, the `some_cool_thing` item has tags `multiple` and `fruit` applied to it.
 
+The root container holds a hidden `.archive` directory where the service keeps its own data.
+
+- `.archive/trash` - deleted entries, see trash.go
+- `.archive/cache/thumbnails` - generated thumbnails, see thumbnails.go
+- `.archive/journal.jsonl` - a log of every change made through the service, see journal.go, it also
+  serves as the undo history, see undo.go
+- `.archive/audits` - stocktakes that are still running, see audits.go
+- `.archive/reminders.json` - the reminders that were already sent, see reminders.go
+- `.archive/webhooks` - changes that were not delivered to webhooks yet, see webhooks.go
+
+With versioning enabled the root container is also a git repository, see versions.go.
+
 */
 
+// stateDir is the hidden directory inside the root container that holds the
+// data the service keeps about the archive.
+const stateDir = ".archive"
+
+// Options configures the optional behavior of a Service.
+type Options struct {
+	// TrashRetention is how long deleted entries are kept in the trash before
+	// they are deleted permanently, zero keeps them forever.
+	TrashRetention time.Duration
+	// MaxAttachmentSize is the size in bytes of the largest attachment that can
+	// be uploaded, zero disables the limit.
+	MaxAttachmentSize int64
+	// Versioning commits every change made through the service to a git
+	// repository at the root container, see versions.go.
+	Versioning bool
+	// PublicURL is the address the web interface is reachable at, labels and
+	// the calendar feed link to entries through it, see labels.go.
+	PublicURL string
+	// Notifiers are sent reminders of upcoming deadlines, low stock and
+	// overdue loans, no reminders are checked if it is empty, see reminders.go.
+	Notifiers []notifier.Notifier
+	// ReminderInterval is how often reminders are checked, zero checks them
+	// every hour.
+	ReminderInterval time.Duration
+	// ReminderDays is how many days before a deadline it is reminded of, and
+	// for how many days after it passed, zero uses a week.
+	ReminderDays int
+	// Webhooks are called for the changes made through the service, see
+	// webhooks.go.
+	Webhooks []Webhook
+}
+
 type Service struct {
 	dir string
+	// realDir is dir with all symlinks resolved
+	realDir    string
+	index      *archiveIndex
+	trash      *trash
+	journal    *journal
+	audits     *audits
+	thumbnails *thumbnailCache
+	stop       chan struct{}
+	// sidecars serializes changes to meta.json files, which are read,
+	// modified and written back as a whole
+	sidecars *sync.Mutex
+	// reverting serializes Undo and Redo so each of them sees the changes
+	// made by the other
+	reverting *sync.Mutex
+	// versions is nil unless Options.Versioning is set
+	versions *versions
+	// reminders is nil unless Options.Notifiers is set
+	reminders *reminders
+	// webhooks is nil unless Options.Webhooks is set
+	webhooks *webhooks
+
+	maxAttachmentSize int64
+	publicURL         string
 }
 
-func NewService(dir string) (Service, error) {
+func NewService(dir string, opts Options) (Service, error) {
 	_, dirname := filepath.Split(dir)
 	if !strings.HasSuffix(dirname, ".container") {
 		return Service{}, fmt.Errorf(
@@ -46,41 +126,98 @@ func NewService(dir string) (Service, error) {
 			dirname,
 		)
 	}
-	return Service{dir: dir}, nil
-}
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return Service{}, fmt.Errorf("NewService: %w", err)
+	}
+	for _, hook := range opts.Webhooks {
+		err = hook.validate()
+		if err != nil {
+			return Service{}, fmt.Errorf("NewService: %w", err)
+		}
+	}
+	var v *versions
+	if opts.Versioning {
+		v, err = openVersions(dir)
+		if err != nil {
+			return Service{}, fmt.Errorf("NewService: %w", err)
+		}
+	}
+	index, err := newArchiveIndex(dir)
+	if err != nil {
+		return Service{}, fmt.Errorf("NewService: %w", err)
+	}
+	s := Service{
+		dir:        dir,
+		realDir:    realDir,
+		index:      index,
+		trash:      newTrash(dir, opts.TrashRetention),
+		journal:    newJournal(dir),
+		audits:     newAudits(dir),
+		thumbnails: newThumbnailCache(dir, index),
+		stop:       make(chan struct{}),
+		sidecars:   &sync.Mutex{},
+		reverting:  &sync.Mutex{},
+		versions:   v,
 
-func (s Service) Read(ctx context.Context, req *connect.Request[v1.ReadRequest]) (*connect.Response[v1.ReadResponse], error) {
-	path := req.Msg.GetPath()
-	fpath := filepath.Join(append([]string{s.dir}, path...)...)
+		maxAttachmentSize: opts.MaxAttachmentSize,
+		publicURL:         opts.PublicURL,
+	}
+	go s.trash.sweepPeriodically(s.stop)
+	go s.thumbnails.prunePeriodically(s.stop)
+	go s.assignUIDsContinuously(s.stop)
+	if len(opts.Notifiers) > 0 {
+		s.reminders = newReminders(dir, opts)
+		go s.remindPeriodically(s.stop)
+	}
+	if len(opts.Webhooks) > 0 {
+		s.webhooks = newWebhooks(dir, opts.Webhooks)
+		go s.webhooks.deliverContinuously(s.stop)
+	}
+	return s, nil
+}
 
-	slog.Debug("reading dir", "dir", fpath)
+// Close stops watching the archive directory for changes and stops all
+// background work.
+func (s Service) Close() error {
+	close(s.stop)
+	return s.index.close()
+}
 
-	_, err := os.Stat(fpath)
+func (s Service) Read(ctx context.Context, req *connect.Request[v1.ReadRequest]) (*connect.Response[v1.ReadResponse], error) {
+	res, err := s.readEntry(req.Msg.GetPath())
 	if err != nil {
 		return nil, err
 	}
-	meta, err := readEntryMeta(fpath)
+	return &connect.Response[v1.ReadResponse]{
+		Msg: res,
+	}, nil
+}
+
+func (s Service) readEntry(path []string) (*v1.ReadResponse, error) {
+	fpath, err := s.resolve(path)
 	if err != nil {
 		return nil, err
 	}
 
+	slog.Debug("reading entry", "dir", fpath)
+
+	entry, ok := s.index.get(path)
+	if !ok {
+		return nil, fsError(path, &os.PathError{Op: "read", Path: fpath, Err: os.ErrNotExist})
+	}
+
 	var children *v1.ReadResponse_Children
-	if len(path) == 0 || strings.HasSuffix(path[len(path)-1], ".container") {
-		items, containers, err := readChildren(fpath)
-		if err != nil {
-			return nil, err
-		}
+	if entry.isContainer {
 		children = &v1.ReadResponse_Children{
-			ItemNames:      items,
-			ContainerNames: containers,
+			ItemNames:      entry.items,
+			ContainerNames: entry.containers,
 		}
 	}
 
-	return &connect.Response[v1.ReadResponse]{
-		Msg: &v1.ReadResponse{
-			Metadata: meta,
-			Children: children,
-		},
+	return &v1.ReadResponse{
+		Metadata: entry.metadata(),
+		Children: children,
 	}, nil
 }
 
@@ -89,39 +226,534 @@ func (s Service) Create(ctx context.Context, req *connect.Request[v1.CreateReque
 	meta := req.Msg.GetMetadata()
 	createContainer := req.Msg.GetCreateContainer()
 
-	err := writeEntryMeta(filepath.Join(path...), meta, createContainer)
+	err := validateEntryName(meta.GetId(), meta.GetTags())
+	if err == nil {
+		err = validateFields(meta.GetFields())
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Create: %w", err))
+	}
+	fpath, err := s.resolveContainer(path)
 	if err != nil {
 		return nil, err
 	}
 
+	newPath := append(path[:len(path):len(path)], formatFilename(meta.GetId(), meta.GetTags(), createContainer))
+	err = writeEntryMeta(fpath, meta, createContainer)
+	if err != nil {
+		return nil, fsError(newPath, err)
+	}
+	s.syncIndex(newPath)
+	s.record(req.Peer(), req.Header(), journalRecord{Operation: "Create", After: newPath})
+
 	return &connect.Response[v1.CreateResponse]{
 		Msg: &v1.CreateResponse{},
 	}, nil
 }
 
+// updatableFields are the fields of EntryMetadata Update can change, the
+// others are changed through their own RPCs or by the service itself.
+var updatableFields = []string{"id", "tags", "description", "image", "image_format", "fields"}
+
+func (s Service) Update(ctx context.Context, req *connect.Request[v1.UpdateRequest]) (*connect.Response[v1.UpdateResponse], error) {
+	path := req.Msg.GetPath()
+	meta := req.Msg.GetMetadata()
+
+	fields := updatableFields
+	if mask := req.Msg.GetUpdateMask(); len(mask.GetPaths()) > 0 {
+		mask.Normalize()
+		if !mask.IsValid(meta) {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("Update: update mask %v contains unknown fields", mask.GetPaths()),
+			)
+		}
+		fields = mask.GetPaths()
+		for _, field := range fields {
+			if !slices.Contains(updatableFields, field) {
+				return nil, connect.NewError(
+					connect.CodeInvalidArgument,
+					fmt.Errorf("Update: %s cannot be updated, it must be one of %s", field, strings.Join(updatableFields, ", ")),
+				)
+			}
+		}
+		// replacing the image with an empty one removes it, so the format alone
+		// must not replace it
+		if slices.Contains(fields, "image_format") && !slices.Contains(fields, "image") {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("Update: image_format can only be updated together with image"),
+			)
+		}
+	}
+
+	resolve := s.resolve
+	for _, field := range fields {
+		var err error
+		switch field {
+		case "id":
+			err = validateName("id", meta.GetId())
+			resolve = s.resolveEntry
+		case "tags":
+			for _, t := range meta.GetTags() {
+				err = errors.Join(err, validateName("tag", t))
+			}
+			resolve = s.resolveEntry
+		case "fields":
+			err = validateFields(meta.GetFields())
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Update: %w", err))
+		}
+	}
+
+	fpath, err := resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(fields, "fields") {
+		s.sidecars.Lock()
+		defer s.sidecars.Unlock()
+	}
+	// the metadata is kept in the journal so the update can be undone, images
+	// are too large for that so updates that replace them cannot be undone
+	undoable := !slices.Contains(fields, "image") && !slices.Contains(fields, "image_format")
+	var metaBefore, metaAfter *journalMeta
+	if undoable {
+		metaBefore, err = readJournalMeta(fpath)
+		if err != nil {
+			return nil, fsError(path, err)
+		}
+	}
+	newFpath, err := updateEntryMeta(fpath, meta, fields)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	if undoable {
+		metaAfter, err = readJournalMeta(newFpath)
+		if err != nil {
+			slog.Warn("failed to read updated metadata, the update cannot be undone", "path", path, "err", err)
+		}
+	}
+
+	newPath := path
+	if newFpath != fpath {
+		newPath = append(path[:len(path)-1:len(path)-1], filepath.Base(newFpath))
+		s.syncIndex(path)
+	}
+	s.syncIndex(newPath)
+	s.record(req.Peer(), req.Header(), journalRecord{
+		Operation:  "Update",
+		Before:     path,
+		After:      newPath,
+		Detail:     strings.Join(fields, ","),
+		MetaBefore: metaBefore,
+		MetaAfter:  metaAfter,
+	})
+
+	return &connect.Response[v1.UpdateResponse]{
+		Msg: &v1.UpdateResponse{
+			Path: newPath,
+		},
+	}, nil
+}
+
+func (s Service) AddImage(ctx context.Context, req *connect.Request[v1.AddImageRequest]) (*connect.Response[v1.AddImageResponse], error) {
+	path := req.Msg.GetPath()
+	img := req.Msg.GetImage()
+	format := req.Msg.GetFormat()
+	if len(img) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("AddImage: image is empty"))
+	}
+	if imageExtension(format) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("AddImage: unknown image format %v", format))
+	}
+
+	fpath, err := s.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	images, hashes, err := readImageHashes(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	sum := sha256.Sum256(img)
+	hash := hex.EncodeToString(sum[:])
+	if slices.Contains(hashes, hash) {
+		return nil, entryError(
+			connect.CodeAlreadyExists,
+			v1.EntryError_ALREADY_EXISTS,
+			path,
+			fmt.Errorf("AddImage: the entry already has the image '%s'", hash),
+		)
+	}
+
+	tmp, err := writeTempImage(fpath, img, format)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	position := len(images)
+	if req.Msg.Position != nil && int(req.Msg.GetPosition()) < position {
+		position = int(req.Msg.GetPosition())
+	}
+	err = arrangeImages(fpath, slices.Insert(images, position, tmp))
+	if err != nil {
+		os.Remove(tmp)
+		return nil, fsError(path, err)
+	}
+	s.syncIndex(path)
+	s.record(req.Peer(), req.Header(), journalRecord{Operation: "AddImage", Before: path, After: path, Detail: hash})
+
+	return &connect.Response[v1.AddImageResponse]{
+		Msg: &v1.AddImageResponse{
+			Image: &v1.ImageRef{
+				Hash:       hash,
+				Format:     format,
+				Url:        imageURL(hash),
+				Thumbnails: thumbnailURLs(hash),
+			},
+		},
+	}, nil
+}
+
+func (s Service) ReorderImages(ctx context.Context, req *connect.Request[v1.ReorderImagesRequest]) (*connect.Response[v1.ReorderImagesResponse], error) {
+	path := req.Msg.GetPath()
+	fpath, images, hashes, err := s.readImages(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Msg.GetHashes()) != len(images) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("ReorderImages: expected %d hashes, got %d", len(images), len(req.Msg.GetHashes())),
+		)
+	}
+
+	order := make([]string, 0, len(images))
+	taken := make([]bool, len(images))
+	for _, hash := range req.Msg.GetHashes() {
+		// an entry may contain the same image more than once, so every
+		// listed hash takes the first image with that hash not taken yet
+		i := -1
+		for j, h := range hashes {
+			if h == hash && !taken[j] {
+				i = j
+				break
+			}
+		}
+		if i < 0 {
+			return nil, imageNotFound("ReorderImages", path, hash)
+		}
+		taken[i] = true
+		order = append(order, images[i])
+	}
+	err = arrangeImages(fpath, order)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	s.syncIndex(path)
+	s.record(req.Peer(), req.Header(), journalRecord{
+		Operation: "ReorderImages",
+		Before:    path,
+		After:     path,
+		Detail:    strings.Join(req.Msg.GetHashes(), ","),
+	})
+
+	return &connect.Response[v1.ReorderImagesResponse]{
+		Msg: &v1.ReorderImagesResponse{},
+	}, nil
+}
+
+func (s Service) SetPrimaryImage(ctx context.Context, req *connect.Request[v1.SetPrimaryImageRequest]) (*connect.Response[v1.SetPrimaryImageResponse], error) {
+	path := req.Msg.GetPath()
+	fpath, images, hashes, err := s.readImages(path)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.Index(hashes, req.Msg.GetHash())
+	if i < 0 {
+		return nil, imageNotFound("SetPrimaryImage", path, req.Msg.GetHash())
+	}
+	primary := images[i]
+	order := append([]string{primary}, slices.Delete(images, i, i+1)...)
+	err = arrangeImages(fpath, order)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	s.syncIndex(path)
+	s.record(req.Peer(), req.Header(), journalRecord{Operation: "SetPrimaryImage", Before: path, After: path, Detail: req.Msg.GetHash()})
+
+	return &connect.Response[v1.SetPrimaryImageResponse]{
+		Msg: &v1.SetPrimaryImageResponse{},
+	}, nil
+}
+
+func (s Service) RemoveImage(ctx context.Context, req *connect.Request[v1.RemoveImageRequest]) (*connect.Response[v1.RemoveImageResponse], error) {
+	path := req.Msg.GetPath()
+	fpath, images, hashes, err := s.readImages(path)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.Index(hashes, req.Msg.GetHash())
+	if i < 0 {
+		return nil, imageNotFound("RemoveImage", path, req.Msg.GetHash())
+	}
+	err = arrangeImages(fpath, slices.Delete(images, i, i+1))
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	s.syncIndex(path)
+	s.record(req.Peer(), req.Header(), journalRecord{Operation: "RemoveImage", Before: path, After: path, Detail: req.Msg.GetHash()})
+
+	return &connect.Response[v1.RemoveImageResponse]{
+		Msg: &v1.RemoveImageResponse{},
+	}, nil
+}
+
+// readImages resolves path and returns the image files of the entry along
+// with their hashes.
+func (s Service) readImages(path []string) (fpath string, images []string, hashes []string, err error) {
+	fpath, err = s.resolve(path)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	images, hashes, err = readImageHashes(fpath)
+	if err != nil {
+		return "", nil, nil, fsError(path, err)
+	}
+	return fpath, images, hashes, nil
+}
+
+func imageNotFound(op string, path []string, hash string) error {
+	return entryError(
+		connect.CodeNotFound,
+		v1.EntryError_IMAGE_NOT_FOUND,
+		path,
+		fmt.Errorf("%s: the entry does not have the image '%s'", op, hash),
+	)
+}
+
 func (s Service) Move(ctx context.Context, req *connect.Request[v1.MoveRequest]) (*connect.Response[v1.MoveResponse], error) {
-	src := filepath.Join(req.Msg.GetSrc()...)
-	dst := filepath.Join(req.Msg.GetDest()...)
-	err := os.Rename(src, dst)
+	srcPath := req.Msg.GetSrc()
+	destPath := req.Msg.GetDest()
+
+	src, err := s.resolveEntry(srcPath)
 	if err != nil {
 		return nil, err
 	}
+	dst, err := s.resolveEntry(destPath)
+	if err != nil {
+		return nil, err
+	}
+	if filepath.Ext(src) != filepath.Ext(dst) {
+		return nil, entryError(
+			connect.CodeInvalidArgument,
+			v1.EntryError_INVALID_PATH,
+			destPath,
+			fmt.Errorf("Move: cannot change the entry type from %s to %s", filepath.Ext(src), filepath.Ext(dst)),
+		)
+	}
+	if isWithinKey(indexKey(destPath), indexKey(srcPath)) {
+		return nil, entryError(
+			connect.CodeFailedPrecondition,
+			v1.EntryError_MOVE_INTO_SELF,
+			destPath,
+			fmt.Errorf("Move: cannot move %v into itself", srcPath),
+		)
+	}
+
+	_, err = os.Lstat(src)
+	if err != nil {
+		return nil, fsError(srcPath, err)
+	}
+	// rename silently replaces empty directories, so collisions must be checked beforehand
+	_, err = os.Lstat(dst)
+	if err == nil {
+		return nil, fsError(destPath, &os.LinkError{Op: "rename", Old: src, New: dst, Err: os.ErrExist})
+	}
+	err = os.Rename(src, dst)
+	if err != nil {
+		return nil, fsError(destPath, err)
+	}
+	s.syncIndex(srcPath)
+	s.syncIndex(destPath)
+	s.record(req.Peer(), req.Header(), journalRecord{Operation: "Move", Before: srcPath, After: destPath})
 	return &connect.Response[v1.MoveResponse]{
 		Msg: &v1.MoveResponse{},
 	}, nil
 }
 
 func (s Service) Delete(ctx context.Context, req *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error) {
-	path := filepath.Join(req.Msg.GetPath()...)
-	err := os.RemoveAll(path)
+	path := req.Msg.GetPath()
+	fpath, err := s.resolveEntry(path)
 	if err != nil {
 		return nil, err
 	}
+	_, err = os.Lstat(fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	trashID, err := s.trash.add(path, fpath)
+	if err != nil {
+		return nil, fsError(path, err)
+	}
+	s.syncIndex(path)
+	s.record(req.Peer(), req.Header(), journalRecord{Operation: "Delete", Before: path, Detail: trashID, TrashID: trashID})
 	return &connect.Response[v1.DeleteResponse]{
-		Msg: &v1.DeleteResponse{},
+		Msg: &v1.DeleteResponse{
+			TrashId: trashID,
+		},
+	}, nil
+}
+
+func (s Service) ListTrash(ctx context.Context, req *connect.Request[v1.ListTrashRequest]) (*connect.Response[v1.ListTrashResponse], error) {
+	entries, err := s.trash.list()
+	if err != nil {
+		return nil, fsError(nil, err)
+	}
+	return &connect.Response[v1.ListTrashResponse]{
+		Msg: &v1.ListTrashResponse{
+			Entries: entries,
+		},
+	}, nil
+}
+
+func (s Service) Restore(ctx context.Context, req *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.RestoreResponse], error) {
+	id := req.Msg.GetId()
+	record, err := s.trash.read(id)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("Restore: trash entry '%s' does not exist", id))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Restore: %w", err))
+	}
+
+	dest := record.Path
+	if len(req.Msg.GetDest()) > 0 {
+		dest = req.Msg.GetDest()
+	}
+	destFpath, err := s.resolveEntry(dest)
+	if err != nil {
+		return nil, err
+	}
+	if filepath.Ext(destFpath) != filepath.Ext(record.Path[len(record.Path)-1]) {
+		return nil, entryError(
+			connect.CodeInvalidArgument,
+			v1.EntryError_INVALID_PATH,
+			dest,
+			fmt.Errorf("Restore: cannot change the entry type of %v", record.Path),
+		)
+	}
+	_, err = os.Lstat(destFpath)
+	if err == nil {
+		return nil, fsError(dest, &os.LinkError{Op: "restore", Old: id, New: destFpath, Err: os.ErrExist})
+	}
+	_, err = os.Stat(filepath.Dir(destFpath))
+	if err != nil {
+		return nil, fsError(dest[:len(dest)-1], err)
+	}
+
+	err = s.trash.restore(id, record, destFpath)
+	if err != nil {
+		return nil, fsError(dest, err)
+	}
+	s.syncIndex(dest)
+	s.record(req.Peer(), req.Header(), journalRecord{Operation: "Restore", Before: record.Path, After: dest, Detail: id})
+
+	return &connect.Response[v1.RestoreResponse]{
+		Msg: &v1.RestoreResponse{
+			Path: dest,
+		},
+	}, nil
+}
+
+func (s Service) EmptyTrash(ctx context.Context, req *connect.Request[v1.EmptyTrashRequest]) (*connect.Response[v1.EmptyTrashResponse], error) {
+	ids := req.Msg.GetIds()
+	if len(ids) == 0 {
+		entries, err := s.trash.list()
+		if err != nil {
+			return nil, fsError(nil, err)
+		}
+		for _, e := range entries {
+			ids = append(ids, e.GetId())
+		}
+	}
+	for _, id := range ids {
+		// the record is only read for the journal, entries with a broken
+		// record can still be removed
+		record, _ := s.trash.read(id)
+		err := s.trash.remove(id)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("EmptyTrash: trash entry '%s' does not exist", id))
+		}
+		if err != nil {
+			return nil, fsError(nil, err)
+		}
+		s.record(req.Peer(), req.Header(), journalRecord{Operation: "EmptyTrash", Before: record.Path, Detail: id})
+	}
+	return &connect.Response[v1.EmptyTrashResponse]{
+		Msg: &v1.EmptyTrashResponse{},
 	}, nil
 }
 
 func (s Service) Search(ctx context.Context, req *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	query, err := parseQuery(req.Msg.GetQuery())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 
+	var entries []*v1.SearchResponse_Entry
+	s.index.walk(func(path []string, e *indexEntry) bool {
+		if !query.match(queryEntry{path: path, meta: e.meta, isContainer: e.isContainer}) {
+			return true
+		}
+		entries = append(entries, &v1.SearchResponse_Entry{
+			Path: path,
+			Meta: e.metadata(),
+		})
+		return true
+	})
+
+	return &connect.Response[v1.SearchResponse]{
+		Msg: &v1.SearchResponse{
+			Entries: entries,
+		},
+	}, nil
+}
+
+func (s Service) Watch(ctx context.Context, req *connect.Request[v1.WatchRequest], stream *connect.ServerStream[v1.WatchResponse]) error {
+	_, err := s.resolve(req.Msg.GetPath())
+	if err != nil {
+		return err
+	}
+
+	events, unsubscribe := s.index.events.subscribe(req.Msg.GetPath())
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return connect.NewError(
+					connect.CodeResourceExhausted,
+					fmt.Errorf("Watch: fell too far behind on archive changes"),
+				)
+			}
+			err := stream.Send(event)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// syncIndex updates the index right away after a mutation so that it is
+// visible to the next request, instead of waiting for the watcher to catch up.
+func (s Service) syncIndex(path []string) {
+	err := s.index.sync(path)
+	if err != nil {
+		slog.Warn("failed to sync index", "path", path, "err", err)
+	}
 }
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newTestService serves a new archive in a temporary directory that contains
+// files, their names are slash separated paths relative to the root and names
+// ending in a slash are directories.
+func newTestService(t *testing.T, files map[string]string) Service {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), "root.container")
+	err := os.Mkdir(dir, 0777)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, contents := range files {
+		fpath := filepath.Join(dir, filepath.FromSlash(name))
+		if strings.HasSuffix(name, "/") {
+			err = os.MkdirAll(fpath, 0777)
+		} else {
+			err = os.MkdirAll(filepath.Dir(fpath), 0777)
+			if err == nil {
+				err = os.WriteFile(fpath, []byte(contents), 0666)
+			}
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	s, err := NewService(dir, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
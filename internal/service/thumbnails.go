@@ -0,0 +1,311 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	v1 "item-archived/api/v1"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/sync/singleflight"
+)
+
+// ThumbnailPattern is the http.ServeMux pattern ServeThumbnail should be registered with.
+const ThumbnailPattern = "GET /thumbnails/{size}/{hash}"
+
+// thumbnailPruneInterval is how often thumbnails of images that are no longer
+// part of the archive are removed.
+const thumbnailPruneInterval = time.Hour
+
+// maxDecodedPixels is the number of pixels of the largest image that is
+// decoded to generate thumbnails or labels, or to locate labels in.
+const maxDecodedPixels = 100_000_000
+
+// thumbnailTempGrace is how old a temporary file in the thumbnail cache has to
+// be before prune removes it, younger ones may still be written to.
+const thumbnailTempGrace = time.Hour
+
+var errImageTooLarge = errors.New("image is too large")
+
+// thumbnail_sizes maps the size names used in urls to the maximum width and
+// height of the thumbnail in pixels.
+var thumbnail_sizes = map[string]int{
+	"small":  64,
+	"medium": 256,
+	"large":  1024,
+}
+
+func thumbnailURL(size, hash string) string {
+	return fmt.Sprintf("/thumbnails/%s/%s", size, hash)
+}
+
+func thumbnailURLs(hash string) *v1.ImageRef_Thumbnails {
+	return &v1.ImageRef_Thumbnails{
+		Small:  thumbnailURL("small", hash),
+		Medium: thumbnailURL("medium", hash),
+		Large:  thumbnailURL("large", hash),
+	}
+}
+
+// thumbnailCache stores generated thumbnails under .archive/cache/thumbnails,
+// thumbnails are keyed by the hash of the source image so a changed image
+// never uses a stale thumbnail.
+type thumbnailCache struct {
+	dir   string
+	index *archiveIndex
+	// unscaled holds the "<hash>-<size>" keys of images that are already
+	// smaller than the thumbnail, so they are not checked again
+	unscaled *sync.Map
+	// generating makes concurrent requests for the same thumbnail wait for a
+	// single generation, decoding a large image takes a lot of memory
+	generating *singleflight.Group
+}
+
+func newThumbnailCache(root string, index *archiveIndex) *thumbnailCache {
+	return &thumbnailCache{
+		dir:        filepath.Join(root, stateDir, "cache", "thumbnails"),
+		index:      index,
+		unscaled:   &sync.Map{},
+		generating: &singleflight.Group{},
+	}
+}
+
+// get returns the path to the thumbnail of the image with the given hash,
+// generating it if necessary. SVG images are returned as is since they scale
+// by themselves, as are images that are already smaller than the thumbnail.
+func (c *thumbnailCache) get(hash, size string) (string, error) {
+	maxSize, ok := thumbnail_sizes[size]
+	if !ok {
+		return "", fmt.Errorf("thumbnailCache.get: unknown size '%s': %w", size, os.ErrNotExist)
+	}
+	source, ok := c.index.image(hash)
+	if !ok {
+		return "", fmt.Errorf("thumbnailCache.get: unknown image '%s': %w", hash, os.ErrNotExist)
+	}
+	ext := strings.TrimPrefix(filepath.Ext(source), ".")
+	if ext == "svg" {
+		return source, nil
+	}
+
+	outExt := "png"
+	if ext == "jpg" {
+		outExt = "jpg"
+	}
+	key := fmt.Sprintf("%s-%s", hash, size)
+	if _, ok := c.unscaled.Load(key); ok {
+		return source, nil
+	}
+	cached := filepath.Join(c.dir, fmt.Sprintf("%s.%s", key, outExt))
+	_, err := os.Stat(cached)
+	if err == nil {
+		return cached, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("thumbnailCache.get: %w", err)
+	}
+
+	fpath, err, _ := c.generating.Do(key, func() (any, error) {
+		// the thumbnail may have been generated while waiting for the
+		// previous generation of the same key to finish
+		if _, err := os.Stat(cached); err == nil {
+			return cached, nil
+		}
+		return c.generate(source, ext, key, cached, maxSize)
+	})
+	if err != nil {
+		return "", fmt.Errorf("thumbnailCache.get: %w", err)
+	}
+	return fpath.(string), nil
+}
+
+// generate writes the thumbnail of the image at source to cached and returns
+// its path, or returns source if the image is already small enough.
+func (c *thumbnailCache) generate(source, ext, key, cached string, maxSize int) (string, error) {
+	config, err := decodeImageConfig(source, ext)
+	if err != nil {
+		return "", fmt.Errorf("thumbnailCache.generate: %w", err)
+	}
+	if config.Width <= maxSize && config.Height <= maxSize {
+		c.unscaled.Store(key, struct{}{})
+		return source, nil
+	}
+	img, err := decodeImage(source, ext)
+	if err != nil {
+		return "", fmt.Errorf("thumbnailCache.generate: %w", err)
+	}
+	bounds := img.Bounds()
+
+	width, height := maxSize, maxSize
+	if bounds.Dx() > bounds.Dy() {
+		height = max(1, bounds.Dy()*maxSize/bounds.Dx())
+	} else {
+		width = max(1, bounds.Dx()*maxSize/bounds.Dy())
+	}
+	thumb := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(thumb, thumb.Bounds(), img, bounds, draw.Src, nil)
+
+	err = os.MkdirAll(c.dir, 0777)
+	if err != nil {
+		return "", fmt.Errorf("thumbnailCache.generate: %w", err)
+	}
+	// write to a temporary file first so concurrent requests never see a
+	// partially written thumbnail
+	tmp, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return "", fmt.Errorf("thumbnailCache.generate: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if filepath.Ext(cached) == ".jpg" {
+		err = jpeg.Encode(tmp, thumb, &jpeg.Options{Quality: 85})
+	} else {
+		err = png.Encode(tmp, thumb)
+	}
+	closeErr := tmp.Close()
+	if err != nil || closeErr != nil {
+		return "", fmt.Errorf("thumbnailCache.generate: %w", errors.Join(err, closeErr))
+	}
+	err = os.Rename(tmp.Name(), cached)
+	if err != nil {
+		return "", fmt.Errorf("thumbnailCache.generate: %w", err)
+	}
+	return cached, nil
+}
+
+// decodeImageConfig reads the dimensions of an image without decoding it.
+func decodeImageConfig(fpath, ext string) (image.Config, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return image.Config{}, fmt.Errorf("decodeImageConfig: %w", err)
+	}
+	defer f.Close()
+
+	var config image.Config
+	switch ext {
+	case "jpg":
+		config, err = jpeg.DecodeConfig(f)
+	case "png":
+		config, err = png.DecodeConfig(f)
+	case "gif":
+		config, err = gif.DecodeConfig(f)
+	default:
+		return config, fmt.Errorf("decodeImageConfig: unsupported image format '%s'", ext)
+	}
+	if err != nil {
+		return config, fmt.Errorf("decodeImageConfig: %w", err)
+	}
+	return config, nil
+}
+
+// decodeImage decodes an image, refusing images with more than
+// maxDecodedPixels pixels, since a small file can hold a huge image that
+// would not fit into memory once decoded.
+func decodeImage(fpath, ext string) (image.Image, error) {
+	config, err := decodeImageConfig(fpath, ext)
+	if err != nil {
+		return nil, fmt.Errorf("decodeImage: %w", err)
+	}
+	if int64(config.Width)*int64(config.Height) > maxDecodedPixels {
+		return nil, fmt.Errorf("decodeImage: %w: %dx%d", errImageTooLarge, config.Width, config.Height)
+	}
+
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, fmt.Errorf("decodeImage: %w", err)
+	}
+	defer f.Close()
+
+	var img image.Image
+	switch ext {
+	case "jpg":
+		img, err = jpeg.Decode(f)
+	case "png":
+		img, err = png.Decode(f)
+	case "gif":
+		// only the first frame is used for animated gifs
+		img, err = gif.Decode(f)
+	default:
+		return nil, fmt.Errorf("decodeImage: unsupported image format '%s'", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decodeImage: %w", err)
+	}
+	return img, nil
+}
+
+// prune removes the thumbnails of images that are no longer in the archive.
+func (c *thumbnailCache) prune() {
+	entries, err := os.ReadDir(c.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return
+	}
+	if err != nil {
+		slog.Warn("failed to prune thumbnails", "err", err)
+		return
+	}
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), "tmp-") {
+			// thumbnails are written to temporary files first, only those
+			// left behind by an interrupted write are removed
+			info, err := e.Info()
+			if err != nil || time.Since(info.ModTime()) < thumbnailTempGrace {
+				continue
+			}
+		} else {
+			hash, _, _ := strings.Cut(e.Name(), "-")
+			if _, ok := c.index.image(hash); ok {
+				continue
+			}
+		}
+		err = os.Remove(filepath.Join(c.dir, e.Name()))
+		if err != nil {
+			slog.Warn("failed to remove stale thumbnail", "name", e.Name(), "err", err)
+		}
+	}
+}
+
+// prunePeriodically prunes the thumbnail cache until stop is closed.
+func (c *thumbnailCache) prunePeriodically(stop <-chan struct{}) {
+	c.prune()
+	ticker := time.NewTicker(thumbnailPruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			c.prune()
+		}
+	}
+}
+
+// ServeThumbnail serves a scaled down version of the image referenced by an
+// ImageRef, thumbnails are generated on first use and cached on disk.
+func (s Service) ServeThumbnail(w http.ResponseWriter, r *http.Request) {
+	hash := r.PathValue("hash")
+	size := r.PathValue("size")
+	fpath, err := s.thumbnails.get(hash, size)
+	if errors.Is(err, os.ErrNotExist) {
+		http.NotFound(w, r)
+		return
+	}
+	if errors.Is(err, errImageTooLarge) {
+		http.Error(w, "the image is too large to generate a thumbnail", http.StatusUnprocessableEntity)
+		return
+	}
+	if err != nil {
+		slog.Warn("failed to generate thumbnail", "hash", hash, "size", size, "err", err)
+		http.Error(w, "failed to generate thumbnail", http.StatusInternalServerError)
+		return
+	}
+	serveImageFile(w, r, fpath, fmt.Sprintf("%s-%s", hash, size))
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestThumbnailCacheConcurrent(t *testing.T) {
+	var buf bytes.Buffer
+	err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, 600, 400)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newTestService(t, map[string]string{"drill.item/image.png": buf.String()})
+	e, ok := s.index.get([]string{"drill.item"})
+	if !ok || len(e.meta.GetImages()) != 1 {
+		t.Fatal("the image is not in the index")
+	}
+	hash := e.meta.GetImages()[0].GetHash()
+
+	const requests = 8
+	var wg sync.WaitGroup
+	paths := make([]string, requests)
+	errs := make([]error, requests)
+	for i := range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			paths[i], errs[i] = s.thumbnails.get(hash, "medium")
+		}()
+	}
+	wg.Wait()
+	for i := range requests {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+		if paths[i] != paths[0] {
+			t.Fatalf("requests got different thumbnails %s and %s", paths[0], paths[i])
+		}
+	}
+	if filepath.Dir(paths[0]) != s.thumbnails.dir {
+		t.Fatalf("thumbnail %s is not in the cache", paths[0])
+	}
+	f, err := os.Open(paths[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	config, err := png.DecodeConfig(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Width != 256 || config.Height != 170 {
+		t.Errorf("thumbnail is %dx%d, want 256x170", config.Width, config.Height)
+	}
+	entries, err := os.ReadDir(s.thumbnails.dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("the cache holds %d files, want only the thumbnail", len(entries))
+	}
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// trashSweepInterval is how often the trash is checked for entries that are
+// past the retention period.
+const trashSweepInterval = time.Hour
+
+// trashRecord is stored as trash.json next to every deleted entry.
+type trashRecord struct {
+	Path      []string  `json:"path"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// trash keeps deleted entries under .archive/trash, every deleted entry gets
+// its own directory holding the entry itself and a trash.json describing
+// where it was deleted from.
+type trash struct {
+	dir       string
+	retention time.Duration
+}
+
+func newTrash(root string, retention time.Duration) *trash {
+	return &trash{
+		dir:       filepath.Join(root, stateDir, "trash"),
+		retention: retention,
+	}
+}
+
+func (t *trash) recordPath(id string) string {
+	return filepath.Join(t.dir, id, "trash.json")
+}
+
+// add moves the entry at fpath into the trash and returns the trash id.
+func (t *trash) add(path []string, fpath string) (string, error) {
+	now := time.Now()
+	err := os.MkdirAll(t.dir, 0777)
+	if err != nil {
+		return "", fmt.Errorf("trash.add: %w", err)
+	}
+	// ids are based on the time, entries deleted at the same time get the
+	// next free id instead
+	var id string
+	for n := now.UnixNano(); ; n++ {
+		id = strconv.FormatInt(n, 10)
+		err = os.Mkdir(filepath.Join(t.dir, id), 0777)
+		if !errors.Is(err, os.ErrExist) {
+			break
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("trash.add: %w", err)
+	}
+	record, err := json.Marshal(trashRecord{Path: path, DeletedAt: now})
+	if err != nil {
+		return "", fmt.Errorf("trash.add: %w", err)
+	}
+	err = os.WriteFile(t.recordPath(id), record, 0600)
+	if err != nil {
+		return "", fmt.Errorf("trash.add: %w", err)
+	}
+	err = os.Rename(fpath, filepath.Join(t.dir, id, filepath.Base(fpath)))
+	if err != nil {
+		os.RemoveAll(filepath.Join(t.dir, id))
+		return "", fmt.Errorf("trash.add: %w", err)
+	}
+	return id, nil
+}
+
+func (t *trash) read(id string) (trashRecord, error) {
+	var record trashRecord
+	if !validTrashID(id) {
+		return record, fmt.Errorf("trash.read: invalid trash id '%s'", id)
+	}
+	contents, err := os.ReadFile(t.recordPath(id))
+	if err != nil {
+		return record, fmt.Errorf("trash.read: %w", err)
+	}
+	err = json.Unmarshal(contents, &record)
+	if err != nil {
+		return record, fmt.Errorf("trash.read: %w", err)
+	}
+	err = validatePath(record.Path)
+	if err != nil || len(record.Path) == 0 {
+		return record, fmt.Errorf("trash.read: trash entry '%s' has an invalid path %v", id, record.Path)
+	}
+	return record, nil
+}
+
+// entryPath returns the location of the deleted entry inside the trash.
+func (t *trash) entryPath(id string, record trashRecord) string {
+	return filepath.Join(t.dir, id, record.Path[len(record.Path)-1])
+}
+
+func (t *trash) list() ([]*v1.TrashEntry, error) {
+	dirs, err := os.ReadDir(t.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("trash.list: %w", err)
+	}
+
+	var entries []*v1.TrashEntry
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		id := d.Name()
+		record, err := t.read(id)
+		if err != nil {
+			slog.Warn("failed to read trash entry", "id", id, "err", err)
+			continue
+		}
+		meta, _, err := readEntryMeta(t.entryPath(id, record))
+		if err != nil {
+			slog.Warn("failed to read trash entry", "id", id, "err", err)
+			continue
+		}
+		entries = append(entries, &v1.TrashEntry{
+			Id:          id,
+			Path:        record.Path,
+			DeletedAt:   timestamppb.New(record.DeletedAt),
+			IsContainer: filepath.Ext(record.Path[len(record.Path)-1]) == ".container",
+			Metadata:    meta,
+		})
+	}
+	slices.SortFunc(entries, func(a, b *v1.TrashEntry) int {
+		return b.GetDeletedAt().AsTime().Compare(a.GetDeletedAt().AsTime())
+	})
+	return entries, nil
+}
+
+// restore moves the deleted entry back to dest.
+func (t *trash) restore(id string, record trashRecord, dest string) error {
+	err := os.Rename(t.entryPath(id, record), dest)
+	if err != nil {
+		return fmt.Errorf("trash.restore: %w", err)
+	}
+	err = os.RemoveAll(filepath.Join(t.dir, id))
+	if err != nil {
+		return fmt.Errorf("trash.restore: %w", err)
+	}
+	return nil
+}
+
+// remove permanently deletes a trash entry.
+func (t *trash) remove(id string) error {
+	if !validTrashID(id) {
+		return fmt.Errorf("trash.remove: invalid trash id '%s'", id)
+	}
+	_, err := os.Lstat(filepath.Join(t.dir, id))
+	if err != nil {
+		return fmt.Errorf("trash.remove: %w", err)
+	}
+	err = os.RemoveAll(filepath.Join(t.dir, id))
+	if err != nil {
+		return fmt.Errorf("trash.remove: %w", err)
+	}
+	return nil
+}
+
+// sweep permanently deletes every trash entry older than the retention period.
+func (t *trash) sweep() {
+	if t.retention <= 0 {
+		return
+	}
+	entries, err := t.list()
+	if err != nil {
+		slog.Warn("failed to sweep trash", "err", err)
+		return
+	}
+	cutoff := time.Now().Add(-t.retention)
+	for _, e := range entries {
+		if e.GetDeletedAt().AsTime().After(cutoff) {
+			continue
+		}
+		err = t.remove(e.GetId())
+		if err != nil {
+			slog.Warn("failed to remove expired trash entry", "id", e.GetId(), "err", err)
+			continue
+		}
+		slog.Debug("removed expired trash entry", "id", e.GetId(), "path", e.GetPath())
+	}
+}
+
+// sweepPeriodically sweeps the trash until stop is closed.
+func (t *trash) sweepPeriodically(stop <-chan struct{}) {
+	t.sweep()
+	ticker := time.NewTicker(trashSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			t.sweep()
+		}
+	}
+}
+
+// validTrashID checks that id is a trash id generated by add, so it cannot be
+// used to reach outside of the trash.
+func validTrashID(id string) bool {
+	return isDigits(id)
+}
+
+// isDigits reports whether s is made up of only the digits 0-9.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
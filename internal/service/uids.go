@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+/*
+
+Every entry below the root container has a uid that is stored in its meta.json, it stays the same
+when the entry is moved or renamed. Entries get their uid when they are created, entries created by
+other programs or before uids existed get one as soon as the service sees them.
+
+Requests can refer to an entry through its uid by starting a path with `@` followed by the uid, the
+rest of the path is relative to that entry: `[@k7vq2xj4m3nd6pby, drill.item]`.
+
+*/
+
+// uidPrefix marks the first segment of a path as the uid of an entry, it
+// cannot be confused with an entry name as those always have an extension.
+const uidPrefix = "@"
+
+var uidEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// path_fields are the fields of request messages that hold paths.
+var path_fields = []protoreflect.Name{"path", "src", "dest", "location"}
+
+func newUID() string {
+	b := make([]byte, 10)
+	// crypto/rand.Read never returns an error on supported platforms
+	_, _ = rand.Read(b)
+	return strings.ToLower(uidEncoding.EncodeToString(b))
+}
+
+// expandPath replaces a uid at the start of path with the path of the entry
+// it belongs to.
+func (s Service) expandPath(path []string) ([]string, error) {
+	if len(path) == 0 || !strings.HasPrefix(path[0], uidPrefix) {
+		return path, nil
+	}
+	uid := strings.TrimPrefix(path[0], uidPrefix)
+	entryPath, ok := s.index.uid(uid)
+	if !ok {
+		return nil, entryError(
+			connect.CodeNotFound,
+			v1.EntryError_NOT_FOUND,
+			path,
+			fmt.Errorf("expandPath: no entry has the uid '%s'", uid),
+		)
+	}
+	return append(entryPath, path[1:]...), nil
+}
+
+// expandPaths expands the uids in the path fields of a request message.
+func (s Service) expandPaths(msg any) error {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+	r := m.ProtoReflect()
+	for _, name := range path_fields {
+		fd := r.Descriptor().Fields().ByName(name)
+		if fd == nil || !fd.IsList() || fd.Kind() != protoreflect.StringKind {
+			continue
+		}
+		list := r.Get(fd).List()
+		if list.Len() == 0 || !strings.HasPrefix(list.Get(0).String(), uidPrefix) {
+			continue
+		}
+		path := make([]string, list.Len())
+		for i := range path {
+			path[i] = list.Get(i).String()
+		}
+		path, err := s.expandPath(path)
+		if err != nil {
+			return err
+		}
+		expanded := r.NewField(fd).List()
+		for _, segment := range path {
+			expanded.Append(protoreflect.ValueOfString(segment))
+		}
+		r.Set(fd, protoreflect.ValueOfList(expanded))
+	}
+	return nil
+}
+
+type uidInterceptor struct {
+	s Service
+}
+
+// UIDInterceptor returns an interceptor that lets every request refer to
+// entries by their uid, it must be installed on the handler of the service.
+func (s Service) UIDInterceptor() connect.Interceptor {
+	return uidInterceptor{s: s}
+}
+
+func (i uidInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		err := i.s.expandPaths(req.Any())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i uidInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i uidInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, uidConn{StreamingHandlerConn: conn, s: i.s})
+	}
+}
+
+type uidConn struct {
+	connect.StreamingHandlerConn
+	s Service
+}
+
+func (c uidConn) Receive(msg any) error {
+	err := c.StreamingHandlerConn.Receive(msg)
+	if err != nil {
+		return err
+	}
+	return c.s.expandPaths(msg)
+}
+
+func (s Service) ResolveID(ctx context.Context, req *connect.Request[v1.ResolveIDRequest]) (*connect.Response[v1.ResolveIDResponse], error) {
+	uid := req.Msg.GetUid()
+	path, ok := s.index.uid(uid)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("ResolveID: no entry has the uid '%s'", uid))
+	}
+	return &connect.Response[v1.ResolveIDResponse]{
+		Msg: &v1.ResolveIDResponse{
+			Path: path,
+		},
+	}, nil
+}
+
+// assignUIDs gives the entries at scope and below it that do not have a uid
+// of their own a new one.
+func (s Service) assignUIDs(scope []string) {
+	prefix := indexKey(scope)
+	type entry struct {
+		path []string
+		uid  string
+	}
+	var missing []entry
+	s.index.walk(func(path []string, e *indexEntry) bool {
+		if !isWithinKey(indexKey(path), prefix) {
+			return true
+		}
+		// walk holds the read lock, so the uids can be looked at directly
+		uid := e.meta.GetUid()
+		if owner, ok := s.index.uids[uid]; uid == "" || !ok || owner != indexKey(path) {
+			missing = append(missing, entry{slices.Clone(path), uid})
+		}
+		return true
+	})
+
+	var assigned [][]string
+	for _, e := range missing {
+		err := s.assignUID(e.path, e.uid)
+		if err != nil {
+			slog.Warn("failed to assign uid", "path", e.path, "err", err)
+			continue
+		}
+		s.index.refreshMeta(e.path)
+		assigned = append(assigned, e.path)
+	}
+	if s.versions != nil && len(assigned) > 0 {
+		// uids are assigned in the background, so they are committed on their
+		// own instead of with whatever change is committed next
+		sidecars := make([][]string, len(assigned))
+		for i, path := range assigned {
+			sidecars[i] = append(slices.Clip(path), sidecarFilename)
+		}
+		err := s.versions.commitPaths(sidecars, uidCommitMessage(assigned), versionsAuthor, time.Now())
+		if err != nil {
+			slog.Warn("failed to commit uids", "err", err)
+		}
+	}
+}
+
+// uidCommitMessage describes the assignment of uids to the entries at paths.
+func uidCommitMessage(paths [][]string) string {
+	if len(paths) == 1 {
+		return fmt.Sprintf("Assign a uid to %s\n", displayPath(paths[0]))
+	}
+	return fmt.Sprintf("Assign uids to %d entries\n", len(paths))
+}
+
+// assignUID gives the entry at path a new uid, unless its uid is no longer
+// the one it had when it was found to need a new one.
+func (s Service) assignUID(path []string, previous string) error {
+	s.sidecars.Lock()
+	defer s.sidecars.Unlock()
+	fpath := s.index.fpath(path)
+	sc, err := readSidecar(fpath)
+	if err != nil {
+		return fmt.Errorf("assignUID: %w", err)
+	}
+	if sc.UID != previous {
+		return nil
+	}
+	sc.UID = newUID()
+	err = writeSidecar(fpath, sc)
+	if err != nil {
+		return fmt.Errorf("assignUID: %w", err)
+	}
+	return nil
+}
+
+// assignUIDsContinuously assigns uids to the entries that do not have one
+// yet, and then to every entry that shows up in the archive.
+func (s Service) assignUIDsContinuously(stop chan struct{}) {
+	for {
+		events, unsubscribe := s.index.events.subscribe(nil)
+		s.assignUIDs(nil)
+		for caughtUp := true; caughtUp; {
+			select {
+			case <-stop:
+				unsubscribe()
+				return
+			case event, ok := <-events:
+				if !ok {
+					// fell behind, start over with the whole archive
+					caughtUp = false
+					break
+				}
+				if event.GetType() == v1.WatchResponse_CREATED {
+					s.assignUIDs(event.GetPath())
+				}
+			}
+		}
+	}
+}
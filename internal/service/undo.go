@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v1 "item-archived/api/v1"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// undoable_operations are the operations whose changes can be undone, the
+// undo history is rebuilt from their journal records.
+var undoable_operations = []string{"Create", "Update", "Move", "Delete"}
+
+// journalMeta is the part of the metadata of an entry that Update changes
+// without renaming the entry, it is kept in the journal so updates can be
+// undone.
+type journalMeta struct {
+	Description *string        `json:"description,omitempty"`
+	Fields      map[string]any `json:"fields,omitempty"`
+}
+
+func readJournalMeta(fpath string) (*journalMeta, error) {
+	meta, _, err := readEntryMeta(fpath)
+	if err != nil {
+		return nil, fmt.Errorf("readJournalMeta: %w", err)
+	}
+	return &journalMeta{
+		Description: meta.Description,
+		Fields:      fieldsToSidecar(meta.GetFields()),
+	}, nil
+}
+
+func (m *journalMeta) equal(other *journalMeta) bool {
+	if (m.Description == nil) != (other.Description == nil) {
+		return false
+	}
+	if m.Description != nil && *m.Description != *other.Description {
+		return false
+	}
+	// dates of fields that are not known are stored as {"date": ...} so the
+	// values cannot always be compared directly
+	if len(m.Fields) == 0 && len(other.Fields) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(m.Fields, other.Fields)
+}
+
+// reversed turns an Undo record back into the change it undid, so it can be
+// made again by Redo.
+func (r journalRecord) reversed() journalRecord {
+	r.Operation = r.Action
+	r.Before, r.After = r.After, r.Before
+	r.MetaBefore, r.MetaAfter = r.MetaAfter, r.MetaBefore
+	return r
+}
+
+// undoStacks replays the journal and returns the changes that can be undone
+// and the changes that can be redone, most recent last. Every change is
+// described in the direction it was originally made by the most recent record
+// that made it, so a change that was undone and redone refers to the trash
+// entry it was moved to most recently.
+func (j *journal) undoStacks() (done, undone []journalRecord, err error) {
+	records, err := j.read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("journal.undoStacks: %w", err)
+	}
+
+	find := func(stack []journalRecord, id string) int {
+		for i := len(stack) - 1; i >= 0; i-- {
+			if stack[i].ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+	for _, r := range records {
+		switch {
+		case r.Operation == "Undo":
+			i := find(done, r.Target)
+			if i < 0 {
+				continue
+			}
+			done = slices.Delete(done, i, i+1)
+			undone = append(undone, r.reversed())
+		case r.Operation == "Redo":
+			i := find(undone, r.Target)
+			if i < 0 {
+				continue
+			}
+			undone = slices.Delete(undone, i, i+1)
+			r.Operation = r.Action
+			done = append(done, r)
+		default:
+			// every other change, even one that cannot be undone, was made
+			// after the changes that were undone, so they cannot be redone
+			undone = nil
+			// records written before undo was supported have no id and
+			// cannot be referred to, so they are left out
+			if r.ID == "" || !slices.Contains(undoable_operations, r.Operation) {
+				continue
+			}
+			if r.Operation == "Update" && (r.MetaBefore == nil || r.MetaAfter == nil) {
+				// updates that replaced the image cannot be undone, see
+				// Update, they would block every change before them
+				continue
+			}
+			done = append(done, r)
+		}
+	}
+	return done, undone, nil
+}
+
+func diverged(op string, path []string, format string, args ...any) error {
+	return entryError(
+		connect.CodeFailedPrecondition,
+		v1.EntryError_DIVERGED,
+		path,
+		fmt.Errorf("%s: %s", op, fmt.Sprintf(format, args...)),
+	)
+}
+
+// applyChange makes the change again, or reverts it if undo is set, after
+// checking that the archive is still in the state the change left it in. The
+// returned record describes what was actually done.
+func (s Service) applyChange(change journalRecord, undo bool) (journalRecord, error) {
+	result := journalRecord{
+		Operation:  "Redo",
+		Action:     change.Operation,
+		Target:     change.ID,
+		Before:     change.Before,
+		After:      change.After,
+		Detail:     change.Detail,
+		MetaBefore: change.MetaBefore,
+		MetaAfter:  change.MetaAfter,
+	}
+	if undo {
+		result.Operation = "Undo"
+		result.Before, result.After = result.After, result.Before
+		result.MetaBefore, result.MetaAfter = result.MetaAfter, result.MetaBefore
+	}
+	op := result.Operation
+	from, to := result.Before, result.After
+
+	switch {
+	case to == nil:
+		// undoing a Create or redoing a Delete moves the entry to the trash
+		fpath, err := s.resolveEntry(from)
+		if err != nil {
+			return journalRecord{}, err
+		}
+		_, err = os.Lstat(fpath)
+		if errors.Is(err, os.ErrNotExist) {
+			return journalRecord{}, diverged(op, from, "%v no longer exists", from)
+		}
+		if err != nil {
+			return journalRecord{}, fsError(from, err)
+		}
+		if undo {
+			// entries that were put into a created container by other
+			// programs would be thrown away with it
+			added, err := hasChildEntries(fpath)
+			if err != nil {
+				return journalRecord{}, fsError(from, err)
+			}
+			if added {
+				return journalRecord{}, diverged(op, from, "entries were added to %v since it was created", from)
+			}
+		}
+		result.TrashID, err = s.trash.add(from, fpath)
+		if err != nil {
+			return journalRecord{}, fsError(from, err)
+		}
+		result.Detail = result.TrashID
+		s.syncIndex(from)
+
+	case from == nil:
+		// undoing a Delete or redoing a Create restores the entry from the trash
+		record, err := s.trash.read(change.TrashID)
+		if err != nil {
+			return journalRecord{}, diverged(op, to, "%v is no longer in the trash", to)
+		}
+		fpath, err := s.resolveEntry(to)
+		if err != nil {
+			return journalRecord{}, err
+		}
+		err = s.checkDestination(op, to, fpath)
+		if err != nil {
+			return journalRecord{}, err
+		}
+		err = s.trash.restore(change.TrashID, record, fpath)
+		if err != nil {
+			return journalRecord{}, fsError(to, err)
+		}
+		result.TrashID = change.TrashID
+		result.Detail = result.TrashID
+		s.syncIndex(to)
+
+	default:
+		src, err := s.resolveEntry(from)
+		if err != nil {
+			return journalRecord{}, err
+		}
+		_, err = os.Lstat(src)
+		if errors.Is(err, os.ErrNotExist) {
+			return journalRecord{}, diverged(op, from, "%v no longer exists", from)
+		}
+		if err != nil {
+			return journalRecord{}, fsError(from, err)
+		}
+		dst, err := s.resolveEntry(to)
+		if err != nil {
+			return journalRecord{}, err
+		}
+		if dst != src {
+			err = s.checkDestination(op, to, dst)
+			if err != nil {
+				return journalRecord{}, err
+			}
+		}
+
+		if change.Operation == "Update" {
+			err = s.applyUpdate(op, result, src)
+		} else {
+			err = os.Rename(src, dst)
+		}
+		if err != nil {
+			return journalRecord{}, fsError(to, err)
+		}
+		if dst != src {
+			s.syncIndex(from)
+		}
+		s.syncIndex(to)
+	}
+	return result, nil
+}
+
+// hasChildEntries reports whether the entry at fpath contains any items or
+// containers.
+func hasChildEntries(fpath string) (bool, error) {
+	children, err := os.ReadDir(fpath)
+	if err != nil {
+		return false, fmt.Errorf("hasChildEntries: %w", err)
+	}
+	for _, c := range children {
+		ext := filepath.Ext(c.Name())
+		if c.IsDir() && (ext == ".item" || ext == ".container") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkDestination checks that an entry can be put at path without replacing
+// another entry.
+func (s Service) checkDestination(op string, path []string, fpath string) error {
+	_, err := os.Lstat(fpath)
+	if err == nil {
+		return diverged(op, path, "%v already exists", path)
+	}
+	_, err = os.Stat(filepath.Dir(fpath))
+	if errors.Is(err, os.ErrNotExist) {
+		return diverged(op, path, "%v no longer exists", path[:len(path)-1])
+	}
+	if err != nil {
+		return fsError(path[:len(path)-1], err)
+	}
+	return nil
+}
+
+// applyUpdate changes the metadata of the entry at fpath from
+// update.MetaBefore to update.MetaAfter and renames it to update.After.
+func (s Service) applyUpdate(op string, update journalRecord, fpath string) error {
+	if update.MetaBefore == nil || update.MetaAfter == nil {
+		// images are not kept in the journal, see Update
+		return connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf("%s: the update of %v changed its images, which cannot be undone", op, update.Before),
+		)
+	}
+	id, tags, _, err := parseFilename(update.After[len(update.After)-1])
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.sidecars.Lock()
+	defer s.sidecars.Unlock()
+	current, err := readJournalMeta(fpath)
+	if err != nil {
+		return err
+	}
+	if !current.equal(update.MetaBefore) {
+		return diverged(op, update.Before, "%v was changed since", update.Before)
+	}
+	_, err = updateEntryMeta(fpath, &v1.EntryMetadata{
+		Id:          id,
+		Tags:        tags,
+		Description: update.MetaAfter.Description,
+		Fields:      fieldsFromSidecar(fpath, &sidecar{Fields: update.MetaAfter.Fields}),
+	}, []string{"id", "tags", "description", "fields"})
+	return err
+}
+
+// replay undoes or redoes up to count changes, starting with the most recent
+// one. Changes that were made before an error are kept.
+func (s Service) replay(peer connect.Peer, header http.Header, count int, undo bool) ([]*v1.JournalEntry, error) {
+	s.reverting.Lock()
+	defer s.reverting.Unlock()
+
+	op := "Redo"
+	done, stack, err := s.journal.undoStacks()
+	if err != nil {
+		return nil, fsError(nil, err)
+	}
+	if undo {
+		op = "Undo"
+		stack = done
+	}
+	if len(stack) == 0 {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf("%s: there are no changes to %s", op, strings.ToLower(op)),
+		)
+	}
+
+	var entries []*v1.JournalEntry
+	for i := len(stack) - 1; i >= 0 && len(entries) < max(count, 1); i-- {
+		result, err := s.applyChange(stack[i], undo)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, journalEntry(s.record(peer, header, result)))
+	}
+	return entries, nil
+}
+
+func (s Service) Undo(ctx context.Context, req *connect.Request[v1.UndoRequest]) (*connect.Response[v1.UndoResponse], error) {
+	entries, err := s.replay(req.Peer(), req.Header(), int(req.Msg.GetCount()), true)
+	if err != nil {
+		return nil, err
+	}
+	return &connect.Response[v1.UndoResponse]{
+		Msg: &v1.UndoResponse{
+			Entries: entries,
+		},
+	}, nil
+}
+
+func (s Service) Redo(ctx context.Context, req *connect.Request[v1.RedoRequest]) (*connect.Response[v1.RedoResponse], error) {
+	entries, err := s.replay(req.Peer(), req.Header(), int(req.Msg.GetCount()), false)
+	if err != nil {
+		return nil, err
+	}
+	return &connect.Response[v1.RedoResponse]{
+		Msg: &v1.RedoResponse{
+			Entries: entries,
+		},
+	}, nil
+}
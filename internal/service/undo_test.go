@@ -0,0 +1,463 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	v1 "item-archived/api/v1"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestJournalMetaEqual(t *testing.T) {
+	description := "drill"
+	other := "saw"
+	tests := []struct {
+		name  string
+		a, b  *journalMeta
+		equal bool
+	}{
+		{"Empty", &journalMeta{}, &journalMeta{}, true},
+		{"NilAndEmptyFields", &journalMeta{}, &journalMeta{Fields: map[string]any{}}, true},
+		{"Description", &journalMeta{Description: &description}, &journalMeta{Description: &description}, true},
+		{"DifferentDescription", &journalMeta{Description: &description}, &journalMeta{Description: &other}, false},
+		{"MissingDescription", &journalMeta{Description: &description}, &journalMeta{}, false},
+		{
+			"Fields",
+			&journalMeta{Fields: map[string]any{"quantity": 3.0, "broken": false, "brand": "Acme"}},
+			&journalMeta{Fields: map[string]any{"quantity": 3.0, "broken": false, "brand": "Acme"}},
+			true,
+		},
+		{
+			"DifferentFields",
+			&journalMeta{Fields: map[string]any{"quantity": 3.0}},
+			&journalMeta{Fields: map[string]any{"quantity": 4.0}},
+			false,
+		},
+		{
+			"Date",
+			&journalMeta{Fields: map[string]any{"last_tuned": map[string]any{"date": "2025-03-14"}}},
+			&journalMeta{Fields: map[string]any{"last_tuned": map[string]any{"date": "2025-03-14"}}},
+			true,
+		},
+		{
+			"DifferentDate",
+			&journalMeta{Fields: map[string]any{"last_tuned": map[string]any{"date": "2025-03-14"}}},
+			&journalMeta{Fields: map[string]any{"last_tuned": map[string]any{"date": "2025-03-15"}}},
+			false,
+		},
+		{
+			"DateAndText",
+			&journalMeta{Fields: map[string]any{"last_tuned": map[string]any{"date": "2025-03-14"}}},
+			&journalMeta{Fields: map[string]any{"last_tuned": "2025-03-14"}},
+			false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.a.equal(test.b); got != test.equal {
+				t.Errorf("a.equal(b) is %v, want %v", got, test.equal)
+			}
+			if got := test.b.equal(test.a); got != test.equal {
+				t.Errorf("b.equal(a) is %v, want %v", got, test.equal)
+			}
+		})
+	}
+}
+
+func TestJournalMetaEqualJournal(t *testing.T) {
+	// the metadata in the journal is compared with the metadata read from
+	// the sidecar, so it has to survive being written to the journal
+	meta := &journalMeta{Fields: fieldsToSidecar(map[string]*v1.FieldValue{
+		"quantity":   {Kind: &v1.FieldValue_Number{Number: 3}},
+		"last_tuned": {Kind: &v1.FieldValue_Date{Date: "2025-03-14"}},
+		"purchased":  {Kind: &v1.FieldValue_Date{Date: "2024-01-02"}},
+	})}
+	contents, err := json.Marshal(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded journalMeta
+	err = json.Unmarshal(contents, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !meta.equal(&decoded) {
+		t.Errorf("%s is not equal to itself after being decoded", contents)
+	}
+}
+
+func TestUndoUpdateWithDateField(t *testing.T) {
+	s := newTestService(t, map[string]string{
+		"drill.item/description.txt": "old",
+		"drill.item/meta.json":       `{"fields": {"last_tuned": {"date": "2025-03-14"}}}`,
+	})
+	ctx := context.Background()
+	description := "new"
+	_, err := s.Update(ctx, connect.NewRequest(&v1.UpdateRequest{
+		Path:       []string{"drill.item"},
+		Metadata:   &v1.EntryMetadata{Description: &description},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.Undo(ctx, connect.NewRequest(&v1.UndoRequest{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := readDescription(t, s, "drill.item"); got != "old" {
+		t.Errorf("description after Undo is %q, want %q", got, "old")
+	}
+	_, err = s.Redo(ctx, connect.NewRequest(&v1.RedoRequest{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := readDescription(t, s, "drill.item"); got != "new" {
+		t.Errorf("description after Redo is %q, want %q", got, "new")
+	}
+}
+
+func readDescription(t *testing.T, s Service, path ...string) string {
+	t.Helper()
+	res, err := s.Read(context.Background(), connect.NewRequest(&v1.ReadRequest{Path: path}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.Msg.GetMetadata().GetDescription()
+}
+
+func TestUndoStacks(t *testing.T) {
+	meta := &journalMeta{}
+	// records are given their position as id, Undo and Redo records target
+	// the record at target, Detail names the change a record is about
+	tests := []struct {
+		name    string
+		records []journalRecord
+		done    []string
+		undone  []string
+	}{
+		{
+			"Changes",
+			[]journalRecord{
+				{Operation: "Create", Detail: "a"},
+				{Operation: "Move", Detail: "b"},
+			},
+			[]string{"a", "b"}, nil,
+		},
+		{
+			"Undo",
+			[]journalRecord{
+				{Operation: "Create", Detail: "a"},
+				{Operation: "Move", Detail: "b"},
+				{Operation: "Undo", Action: "Move", Target: "1", Detail: "b"},
+			},
+			[]string{"a"}, []string{"b"},
+		},
+		{
+			"Redo",
+			[]journalRecord{
+				{Operation: "Create", Detail: "a"},
+				{Operation: "Undo", Action: "Create", Target: "0", Detail: "a"},
+				{Operation: "Redo", Action: "Create", Target: "1", Detail: "a"},
+			},
+			[]string{"a"}, nil,
+		},
+		{
+			"ChangeAfterUndo",
+			[]journalRecord{
+				{Operation: "Create", Detail: "a"},
+				{Operation: "Undo", Action: "Create", Target: "0", Detail: "a"},
+				{Operation: "Create", Detail: "b"},
+			},
+			[]string{"b"}, nil,
+		},
+		{
+			"ChangeThatCannotBeUndoneAfterUndo",
+			[]journalRecord{
+				{Operation: "Create", Detail: "a"},
+				{Operation: "Undo", Action: "Create", Target: "0", Detail: "a"},
+				{Operation: "AdjustQuantity", Detail: "b"},
+			},
+			nil, nil,
+		},
+		{
+			"ImageUpdateAfterUndo",
+			[]journalRecord{
+				{Operation: "Update", Detail: "a", MetaBefore: meta, MetaAfter: meta},
+				{Operation: "Undo", Action: "Update", Target: "0", Detail: "a", MetaBefore: meta, MetaAfter: meta},
+				{Operation: "Update", Detail: "b"},
+			},
+			nil, nil,
+		},
+		{
+			"ImageUpdate",
+			[]journalRecord{
+				{Operation: "Create", Detail: "a"},
+				{Operation: "Update", Detail: "b"},
+			},
+			[]string{"a"}, nil,
+		},
+		{
+			"WithoutID",
+			[]journalRecord{
+				{Operation: "Create", Detail: "a"},
+				{Operation: "Create", Detail: "b", ID: "-"},
+			},
+			[]string{"a"}, nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			j := newJournal(t.TempDir())
+			os.MkdirAll(filepath.Dir(j.fpath), 0777)
+			var lines []byte
+			for i, r := range test.records {
+				switch r.ID {
+				case "":
+					r.ID = strconv.Itoa(i)
+				case "-":
+					r.ID = ""
+				}
+				line, err := json.Marshal(r)
+				if err != nil {
+					t.Fatal(err)
+				}
+				lines = append(append(lines, line...), '\n')
+			}
+			err := os.WriteFile(j.fpath, lines, 0666)
+			if err != nil {
+				t.Fatal(err)
+			}
+			done, undone, err := j.undoStacks()
+			if err != nil {
+				t.Fatal(err)
+			}
+			details := func(records []journalRecord) []string {
+				var details []string
+				for _, r := range records {
+					details = append(details, r.Detail)
+				}
+				return details
+			}
+			if got := details(done); !slices.Equal(got, test.done) {
+				t.Errorf("done is %q, want %q", got, test.done)
+			}
+			if got := details(undone); !slices.Equal(got, test.undone) {
+				t.Errorf("undone is %q, want %q", got, test.undone)
+			}
+		})
+	}
+}
+
+func TestUndoRedo(t *testing.T) {
+	description := "cordless"
+	tests := []struct {
+		name   string
+		change func(ctx context.Context, s Service) error
+		// original are the paths that only exist before the change,
+		// changed the ones that only exist after it
+		original []string
+		changed  []string
+	}{
+		{
+			"Create",
+			func(ctx context.Context, s Service) error {
+				_, err := s.Create(ctx, connect.NewRequest(&v1.CreateRequest{
+					Path:            []string{"garage.container"},
+					Metadata:        &v1.EntryMetadata{Id: "box"},
+					CreateContainer: true,
+				}))
+				return err
+			},
+			nil,
+			[]string{"garage.container/box.container"},
+		},
+		{
+			"Move",
+			func(ctx context.Context, s Service) error {
+				_, err := s.Move(ctx, connect.NewRequest(&v1.MoveRequest{
+					Src:  []string{"garage.container", "drill.item"},
+					Dest: []string{"shed.container", "drill.item"},
+				}))
+				return err
+			},
+			[]string{"garage.container/drill.item"},
+			[]string{"shed.container/drill.item"},
+		},
+		{
+			"Delete",
+			func(ctx context.Context, s Service) error {
+				_, err := s.Delete(ctx, connect.NewRequest(&v1.DeleteRequest{
+					Path: []string{"garage.container", "drill.item"},
+				}))
+				return err
+			},
+			[]string{"garage.container/drill.item"},
+			nil,
+		},
+		{
+			"Update",
+			func(ctx context.Context, s Service) error {
+				_, err := s.Update(ctx, connect.NewRequest(&v1.UpdateRequest{
+					Path:       []string{"garage.container", "drill.item"},
+					Metadata:   &v1.EntryMetadata{Id: "drill", Tags: []string{"bosch"}, Description: &description},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags", "description"}},
+				}))
+				return err
+			},
+			[]string{"garage.container/drill.item"},
+			[]string{"garage.container/drill.bosch.item"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newTestService(t, map[string]string{
+				"garage.container/drill.item/description.txt": "drill",
+				"shed.container/": "",
+			})
+			ctx := context.Background()
+			check := func(step string, exist, gone []string) {
+				t.Helper()
+				for _, name := range exist {
+					if _, err := os.Lstat(filepath.Join(s.dir, filepath.FromSlash(name))); err != nil {
+						t.Fatalf("after %s: %s does not exist: %v", step, name, err)
+					}
+				}
+				for _, name := range gone {
+					if _, err := os.Lstat(filepath.Join(s.dir, filepath.FromSlash(name))); err == nil {
+						t.Fatalf("after %s: %s still exists", step, name)
+					}
+				}
+			}
+
+			err := test.change(ctx, s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			check("the change", test.changed, test.original)
+			// undoing and redoing twice also covers undoing a redone change,
+			// which refers to the trash entry it was moved to by the redo
+			for range 2 {
+				_, err = s.Undo(ctx, connect.NewRequest(&v1.UndoRequest{}))
+				if err != nil {
+					t.Fatal(err)
+				}
+				check("Undo", test.original, test.changed)
+				_, err = s.Redo(ctx, connect.NewRequest(&v1.RedoRequest{}))
+				if err != nil {
+					t.Fatal(err)
+				}
+				check("Redo", test.changed, test.original)
+			}
+		})
+	}
+}
+
+func TestUndoDiverged(t *testing.T) {
+	tests := []struct {
+		name string
+		// change is made through the service, edit by another program
+		// afterwards
+		change func(ctx context.Context, s Service) error
+		edit   func(dir string) error
+	}{
+		{
+			"MovedEntryRemoved",
+			func(ctx context.Context, s Service) error {
+				_, err := s.Move(ctx, connect.NewRequest(&v1.MoveRequest{
+					Src:  []string{"garage.container", "drill.item"},
+					Dest: []string{"shed.container", "drill.item"},
+				}))
+				return err
+			},
+			func(dir string) error {
+				return os.RemoveAll(filepath.Join(dir, "shed.container", "drill.item"))
+			},
+		},
+		{
+			"MovedEntryReplaced",
+			func(ctx context.Context, s Service) error {
+				_, err := s.Move(ctx, connect.NewRequest(&v1.MoveRequest{
+					Src:  []string{"garage.container", "drill.item"},
+					Dest: []string{"shed.container", "drill.item"},
+				}))
+				return err
+			},
+			func(dir string) error {
+				return os.Mkdir(filepath.Join(dir, "garage.container", "drill.item"), 0777)
+			},
+		},
+		{
+			"UpdatedEntryChanged",
+			func(ctx context.Context, s Service) error {
+				description := "new"
+				_, err := s.Update(ctx, connect.NewRequest(&v1.UpdateRequest{
+					Path:       []string{"garage.container", "drill.item"},
+					Metadata:   &v1.EntryMetadata{Description: &description},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+				}))
+				return err
+			},
+			func(dir string) error {
+				return os.WriteFile(filepath.Join(dir, "garage.container", "drill.item", "description.txt"), []byte("edited"), 0666)
+			},
+		},
+		{
+			"CreatedContainerFilled",
+			func(ctx context.Context, s Service) error {
+				_, err := s.Create(ctx, connect.NewRequest(&v1.CreateRequest{
+					Metadata:        &v1.EntryMetadata{Id: "box"},
+					CreateContainer: true,
+				}))
+				return err
+			},
+			func(dir string) error {
+				return os.Mkdir(filepath.Join(dir, "box.container", "saw.item"), 0777)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newTestService(t, map[string]string{
+				"garage.container/drill.item/description.txt": "drill",
+				"shed.container/": "",
+			})
+			ctx := context.Background()
+			err := test.change(ctx, s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = test.edit(s.dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = s.Undo(ctx, connect.NewRequest(&v1.UndoRequest{}))
+			if reason := entryErrorReason(err); reason != v1.EntryError_DIVERGED {
+				t.Fatalf("Undo returned %v, want a diverged error", err)
+			}
+		})
+	}
+}
+
+// entryErrorReason returns the reason of the EntryError detail of err,
+// UNKNOWN if it has none.
+func entryErrorReason(err error) v1.EntryError_Reason {
+	var cerr *connect.Error
+	if !errors.As(err, &cerr) {
+		return v1.EntryError_UNKNOWN
+	}
+	for _, detail := range cerr.Details() {
+		value, err := detail.Value()
+		if e, ok := value.(*v1.EntryError); err == nil && ok {
+			return e.GetReason()
+		}
+	}
+	return v1.EntryError_UNKNOWN
+}
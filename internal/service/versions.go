@@ -0,0 +1,583 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	v1 "item-archived/api/v1"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// versionsAuthor is the author of commits that are not made on behalf of a
+// client.
+const versionsAuthor = "item-archived"
+
+// versions commits the archive directory to a git repository at the root
+// container after every change made through the service, see
+// Options.Versioning.
+type versions struct {
+	repo *git.Repository
+	mu   sync.Mutex
+}
+
+// openVersions opens the git repository at root, creating it if it does not
+// exist yet, and commits anything that changed while the service was not
+// running.
+func openVersions(root string) (*versions, error) {
+	repo, err := git.PlainOpen(root)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		repo, err = git.PlainInit(root, false)
+		if err == nil {
+			err = excludeStateDir(root)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("openVersions: %w", err)
+	}
+	v := &versions{repo: repo}
+	err = v.commit("Snapshot the archive\n", versionsAuthor, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("openVersions: %w", err)
+	}
+	return v, nil
+}
+
+// excludeStateDir keeps the data of the service out of the way of git
+// commands run on the repository, the service itself excludes it on its own.
+func excludeStateDir(root string) error {
+	info := filepath.Join(root, ".git", "info")
+	err := os.MkdirAll(info, 0777)
+	if err != nil {
+		return fmt.Errorf("excludeStateDir: %w", err)
+	}
+	err = os.WriteFile(filepath.Join(info, "exclude"), []byte("/"+stateDir+"/\n"), 0666)
+	if err != nil {
+		return fmt.Errorf("excludeStateDir: %w", err)
+	}
+	return nil
+}
+
+// add commits the change described by a journal record, the change has
+// already been made so failures are only logged.
+func (v *versions) add(record journalRecord) {
+	var paths [][]string
+	for _, path := range [][]string{record.Before, record.After} {
+		if path != nil {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		// the record did not change anything in the archive itself
+		return
+	}
+	err := v.commitPaths(paths, commitMessage(record), record.Client, record.Time)
+	if err != nil {
+		slog.Warn("failed to commit change", "operation", record.Operation, "err", err)
+	}
+}
+
+// commit stages every change in the archive directory and commits it, nothing
+// is committed if nothing changed. This looks at every file in the archive, so
+// it is only done when the service starts.
+func (v *versions) commit(message, author string, when time.Time) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	w, err := v.repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("versions.commit: %w", err)
+	}
+	// the data of the service and files that are still being written are
+	// not part of the archive
+	w.Excludes = append(w.Excludes,
+		gitignore.ParsePattern("/"+stateDir, nil),
+		gitignore.ParsePattern(".tmp-*", nil),
+	)
+	status, err := w.Status()
+	if err != nil {
+		return fmt.Errorf("versions.commit: %w", err)
+	}
+	if status.IsClean() {
+		return nil
+	}
+	for file, s := range status {
+		if s.Worktree == git.Unmodified {
+			continue
+		}
+		if s.Worktree == git.Deleted {
+			_, err = w.Remove(file)
+		} else {
+			_, err = w.Add(file)
+		}
+		if err != nil {
+			return fmt.Errorf("versions.commit: %w", err)
+		}
+	}
+	err = v.commitIndex(w, message, author, when)
+	if err != nil {
+		return fmt.Errorf("versions.commit: %w", err)
+	}
+	return nil
+}
+
+// commitPaths stages the files at paths, which are entries or files inside
+// them, and commits them, nothing is committed if none of them changed. Only
+// those files are looked at, so changes elsewhere in the archive are not part
+// of the commit. The root container is too large to stage like this, paths
+// that name it commit everything like commit does.
+func (v *versions) commitPaths(paths [][]string, message, author string, when time.Time) error {
+	if slices.ContainsFunc(paths, func(p []string) bool { return len(p) == 0 }) {
+		return v.commit(message, author, when)
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	w, err := v.repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("versions.commitPaths: %w", err)
+	}
+	idx, err := v.repo.Storer.Index()
+	if err != nil {
+		return fmt.Errorf("versions.commitPaths: %w", err)
+	}
+	changed := false
+	for _, path := range paths {
+		c, err := v.stage(idx, w.Filesystem.Root(), strings.Join(path, "/"))
+		if err != nil {
+			return fmt.Errorf("versions.commitPaths: %w", err)
+		}
+		changed = changed || c
+	}
+	if !changed {
+		return nil
+	}
+	err = v.repo.Storer.SetIndex(idx)
+	if err != nil {
+		return fmt.Errorf("versions.commitPaths: %w", err)
+	}
+	err = v.commitIndex(w, message, author, when)
+	if err != nil {
+		return fmt.Errorf("versions.commitPaths: %w", err)
+	}
+	return nil
+}
+
+// stage updates the entries of idx for the file or directory at name, which is
+// relative to root, to match what is on disk and reports whether anything
+// changed. Files whose size and modification time did not change are not read
+// again.
+func (v *versions) stage(idx *index.Index, root, name string) (bool, error) {
+	staged := make(map[string]*index.Entry)
+	for _, e := range idx.Entries {
+		if e.Name == name || strings.HasPrefix(e.Name, name+"/") {
+			staged[e.Name] = e
+		}
+	}
+
+	changed := false
+	seen := make(map[string]bool)
+	err := filepath.WalkDir(filepath.Join(root, filepath.FromSlash(name)), func(fpath string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) {
+			// the entry was deleted or moved away
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		// files that are still being written are not part of the archive
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".tmp-") {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		mode, err := filemode.NewFromOSFileMode(info.Mode())
+		if err != nil || (!mode.IsRegular() && mode != filemode.Symlink) {
+			return nil
+		}
+		rel, err := filepath.Rel(root, fpath)
+		if err != nil {
+			return err
+		}
+		file := filepath.ToSlash(rel)
+		seen[file] = true
+
+		e, ok := staged[file]
+		if ok && e.Mode == mode && e.Size == uint32(info.Size()) && e.ModifiedAt.Equal(info.ModTime()) {
+			return nil
+		}
+		hash, err := v.storeBlob(fpath, info)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			e = idx.Add(file)
+		}
+		changed = changed || !ok || e.Hash != hash || e.Mode != mode
+		e.Hash = hash
+		e.Mode = mode
+		e.Size = uint32(info.Size())
+		e.ModifiedAt = info.ModTime()
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("versions.stage: %w", err)
+	}
+
+	for file := range staged {
+		if !seen[file] {
+			_, err = idx.Remove(file)
+			if err != nil {
+				return false, fmt.Errorf("versions.stage: %w", err)
+			}
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+// storeBlob stores the contents of the file at fpath in the repository, the
+// target of symlinks is stored instead of the file they point to.
+func (v *versions) storeBlob(fpath string, info fs.FileInfo) (plumbing.Hash, error) {
+	var contents io.Reader
+	size := info.Size()
+	if info.Mode()&fs.ModeSymlink != 0 {
+		target, err := os.Readlink(fpath)
+		if err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("versions.storeBlob: %w", err)
+		}
+		contents = strings.NewReader(filepath.ToSlash(target))
+		size = int64(len(filepath.ToSlash(target)))
+	} else {
+		f, err := os.Open(fpath)
+		if err != nil {
+			return plumbing.ZeroHash, fmt.Errorf("versions.storeBlob: %w", err)
+		}
+		defer f.Close()
+		contents = f
+	}
+
+	obj := v.repo.Storer.NewEncodedObject()
+	obj.SetType(plumbing.BlobObject)
+	obj.SetSize(size)
+	w, err := obj.Writer()
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("versions.storeBlob: %w", err)
+	}
+	_, err = io.Copy(w, contents)
+	closeErr := w.Close()
+	if err != nil || closeErr != nil {
+		return plumbing.ZeroHash, fmt.Errorf("versions.storeBlob: %w", errors.Join(err, closeErr))
+	}
+	hash, err := v.repo.Storer.SetEncodedObject(obj)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("versions.storeBlob: %w", err)
+	}
+	return hash, nil
+}
+
+// commitIndex commits what is staged in the index.
+func (v *versions) commitIndex(w *git.Worktree, message, author string, when time.Time) error {
+	_, err := w.Commit(message, &git.CommitOptions{
+		Author: &object.Signature{Name: author, When: when},
+		// something did change, but go-git considers every commit without
+		// files empty, which is the case after the last entry is deleted
+		AllowEmptyCommits: true,
+	})
+	if err != nil {
+		return fmt.Errorf("versions.commitIndex: %w", err)
+	}
+	return nil
+}
+
+func displayPath(path []string) string {
+	if len(path) == 0 {
+		return "the archive"
+	}
+	return strings.Join(path, "/")
+}
+
+// commitMessage names the operation and the paths it changed in the first
+// line, the detail of the record follows in the body.
+func commitMessage(r journalRecord) string {
+	op := r.Operation
+	if r.Action != "" {
+		op += " " + r.Action
+	}
+	subject := op
+	switch {
+	case r.Before == nil && r.After == nil:
+	case r.Before == nil:
+		subject = fmt.Sprintf("%s %s", op, displayPath(r.After))
+	case r.After == nil || slices.Equal(r.Before, r.After):
+		subject = fmt.Sprintf("%s %s", op, displayPath(r.Before))
+	default:
+		subject = fmt.Sprintf("%s %s to %s", op, displayPath(r.Before), displayPath(r.After))
+	}
+	if r.Detail == "" {
+		return subject + "\n"
+	}
+	return fmt.Sprintf("%s\n\n%s\n", subject, r.Detail)
+}
+
+func revision(c *object.Commit) *v1.Revision {
+	return &v1.Revision{
+		Id:      c.Hash.String(),
+		Time:    timestamppb.New(c.Author.When),
+		Client:  c.Author.Name,
+		Message: c.Message,
+	}
+}
+
+// list returns the revisions that changed path or anything inside it, most
+// recent first.
+func (v *versions) list(path []string, limit int) ([]*v1.Revision, error) {
+	head, err := v.repo.Head()
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("versions.list: %w", err)
+	}
+	opts := &git.LogOptions{From: head.Hash()}
+	if prefix := strings.Join(path, "/"); prefix != "" {
+		opts.PathFilter = func(file string) bool {
+			return file == prefix || strings.HasPrefix(file, prefix+"/")
+		}
+	}
+	commits, err := v.repo.Log(opts)
+	if err != nil {
+		return nil, fmt.Errorf("versions.list: %w", err)
+	}
+	defer commits.Close()
+
+	var revisions []*v1.Revision
+	err = commits.ForEach(func(c *object.Commit) error {
+		revisions = append(revisions, revision(c))
+		if limit > 0 && len(revisions) >= limit {
+			return storer.ErrStop
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("versions.list: %w", err)
+	}
+	return revisions, nil
+}
+
+// commitAt resolves a revision to the commit it names.
+func (v *versions) commitAt(rev string) (*object.Commit, error) {
+	hash, err := v.repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return nil, fmt.Errorf("versions.commitAt: %w", err)
+	}
+	commit, err := v.repo.CommitObject(*hash)
+	if err != nil {
+		return nil, fmt.Errorf("versions.commitAt: %w", err)
+	}
+	return commit, nil
+}
+
+func readBlob(f *object.File) ([]byte, error) {
+	r, err := f.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// readTreeMeta is like readEntryMeta and readChildren for an entry as it was
+// stored in a commit, name is the filename of the entry.
+func readTreeMeta(tree *object.Tree, name string) (meta *v1.EntryMetadata, items []string, containers []string, err error) {
+	id, tags, _, err := parseFilename(name)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("readTreeMeta: %w", err)
+	}
+	meta = &v1.EntryMetadata{
+		Id:   id,
+		Tags: tags,
+	}
+
+	type image struct {
+		file     string
+		position int
+		format   v1.ImageFormat
+	}
+	var images []image
+	for _, e := range tree.Entries {
+		if e.Mode == filemode.Dir {
+			if strings.HasSuffix(e.Name, ".container") {
+				containers = append(containers, e.Name)
+			} else if strings.HasSuffix(e.Name, ".item") {
+				items = append(items, e.Name)
+			}
+			continue
+		}
+		if position, format, ok := parseImageFilename(e.Name); ok {
+			images = append(images, image{e.Name, position, format})
+			continue
+		}
+		if e.Name != "description.txt" && e.Name != sidecarFilename {
+			continue
+		}
+
+		f, err := tree.File(e.Name)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("readTreeMeta: %w", err)
+		}
+		contents, err := readBlob(f)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("readTreeMeta: %w", err)
+		}
+		if e.Name == "description.txt" {
+			description := string(contents)
+			meta.Description = &description
+			continue
+		}
+		sc := &sidecar{}
+		err = json.Unmarshal(contents, sc)
+		if err != nil {
+			slog.Warn("failed to read sidecar", "entry", name, "err", err)
+			continue
+		}
+		meta.Fields = fieldsFromSidecar(name, sc)
+		meta.Uid = sc.UID
+	}
+
+	slices.SortFunc(images, func(a, b image) int {
+		if a.position != b.position {
+			return a.position - b.position
+		}
+		return int(a.format) - int(b.format)
+	})
+	for _, img := range images {
+		f, err := tree.File(img.file)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("readTreeMeta: %w", err)
+		}
+		contents, err := readBlob(f)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("readTreeMeta: %w", err)
+		}
+		sum := sha256.Sum256(contents)
+		meta.Images = append(meta.Images, &v1.ImageRef{
+			Hash:   hex.EncodeToString(sum[:]),
+			Format: img.format,
+		})
+	}
+	if len(meta.Images) > 0 {
+		format := meta.Images[0].GetFormat()
+		meta.ImageRef = meta.Images[0]
+		meta.ImageFormat = &format
+	}
+	return meta, items, containers, nil
+}
+
+func versioningDisabled(op string) error {
+	return connect.NewError(
+		connect.CodeFailedPrecondition,
+		fmt.Errorf("%s: versioning is not enabled for this archive", op),
+	)
+}
+
+func (s Service) ListRevisions(ctx context.Context, req *connect.Request[v1.ListRevisionsRequest]) (*connect.Response[v1.ListRevisionsResponse], error) {
+	if s.versions == nil {
+		return nil, versioningDisabled("ListRevisions")
+	}
+	path := req.Msg.GetPath()
+	_, err := s.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := s.versions.list(path, int(req.Msg.GetLimit()))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &connect.Response[v1.ListRevisionsResponse]{
+		Msg: &v1.ListRevisionsResponse{
+			Revisions: revisions,
+		},
+	}, nil
+}
+
+func (s Service) ReadAt(ctx context.Context, req *connect.Request[v1.ReadAtRequest]) (*connect.Response[v1.ReadAtResponse], error) {
+	if s.versions == nil {
+		return nil, versioningDisabled("ReadAt")
+	}
+	path := req.Msg.GetPath()
+	rev := req.Msg.GetRevision()
+	fpath, err := s.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	commit, err := s.versions.commitAt(rev)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("ReadAt: revision '%s' does not exist: %w", rev, err))
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("ReadAt: %w", err))
+	}
+	if len(path) > 0 {
+		tree, err = tree.Tree(strings.Join(path, "/"))
+	}
+	if errors.Is(err, object.ErrDirectoryNotFound) {
+		return nil, entryError(
+			connect.CodeNotFound,
+			v1.EntryError_NOT_FOUND,
+			path,
+			fmt.Errorf("ReadAt: %v does not exist at revision '%s'", path, rev),
+		)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("ReadAt: %w", err))
+	}
+
+	meta, items, containers, err := readTreeMeta(tree, filepath.Base(fpath))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("ReadAt: %w", err))
+	}
+	for _, img := range meta.GetImages() {
+		// only images that are still in the archive are served
+		if _, ok := s.index.image(img.GetHash()); ok {
+			img.Url = imageURL(img.GetHash())
+			img.Thumbnails = thumbnailURLs(img.GetHash())
+		}
+	}
+	var children *v1.ReadResponse_Children
+	if len(path) == 0 || strings.HasSuffix(path[len(path)-1], ".container") {
+		children = &v1.ReadResponse_Children{
+			ItemNames:      items,
+			ContainerNames: containers,
+		}
+	}
+
+	return &connect.Response[v1.ReadAtResponse]{
+		Msg: &v1.ReadAtResponse{
+			Metadata: meta,
+			Children: children,
+			Revision: revision(commit),
+		},
+	}, nil
+}
@@ -0,0 +1,376 @@
+package service
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The headers sent with every webhook delivery. SignatureHeader is only sent
+// to webhooks with a secret, it holds "sha256=" followed by the hex encoded
+// HMAC-SHA256 of the body keyed with the secret.
+const (
+	EventHeader     = "X-Archive-Event"
+	DeliveryHeader  = "X-Archive-Delivery"
+	SignatureHeader = "X-Archive-Signature"
+)
+
+// maxWebhookAttempts is how often a delivery is attempted before it is
+// dropped, the delay between attempts starts at webhookRetryDelay and doubles
+// up to maxWebhookRetryDelay.
+const (
+	maxWebhookAttempts   = 12
+	webhookRetryDelay    = 10 * time.Second
+	maxWebhookRetryDelay = time.Hour
+)
+
+// webhookTimeout is how long a webhook gets to respond to a delivery.
+const webhookTimeout = 10 * time.Second
+
+// webhookCheckInterval is how often deliveries are checked for retries.
+const webhookCheckInterval = 5 * time.Second
+
+// webhook_events are the operations webhooks can subscribe to, they are the
+// operations of the journal records of the changes.
+var webhook_events = []string{
+	"Create", "Update", "Move", "Delete", "Restore", "EmptyTrash",
+	"AddImage", "RemoveImage", "SetPrimaryImage", "ReorderImages", "UploadAttachment",
+	"AdjustQuantity", "CheckOut", "CheckIn", "LogMaintenance",
+	"Undo", "Redo",
+}
+
+// Webhook subscribes a url to the changes made through the service, they are
+// posted to it as json like webhookEvent.
+type Webhook struct {
+	URL string `json:"url"`
+	// Events are the operations the webhook is called for, all of them if it
+	// is empty. Undo and Redo records also match the operation they undid or
+	// redid.
+	Events []string `json:"events,omitempty"`
+	// Path limits the webhook to changes of entries in the container at
+	// Path, or of the entry at Path itself.
+	Path []string `json:"path,omitempty"`
+	// Secret is used to sign the deliveries, see SignatureHeader.
+	Secret string `json:"secret,omitempty"`
+}
+
+func (w Webhook) validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		return fmt.Errorf("Webhook.validate: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("Webhook.validate: '%s' is not an http or https url", w.URL)
+	}
+	for _, event := range w.Events {
+		if !slices.Contains(webhook_events, event) {
+			return fmt.Errorf("Webhook.validate: unknown event '%s', it must be one of %s", event, strings.Join(webhook_events, ", "))
+		}
+	}
+	return nil
+}
+
+// matches reports whether the webhook is called for record.
+func (w Webhook) matches(record journalRecord) bool {
+	if len(w.Events) > 0 && !slices.Contains(w.Events, record.Operation) && !slices.Contains(w.Events, record.Action) {
+		return false
+	}
+	if len(w.Path) == 0 {
+		return true
+	}
+	return (record.Before != nil && hasPathPrefix(record.Before, w.Path)) ||
+		(record.After != nil && hasPathPrefix(record.After, w.Path))
+}
+
+// ReadWebhooks reads a json array of webhooks from a file.
+func ReadWebhooks(fpath string) ([]Webhook, error) {
+	contents, err := os.ReadFile(fpath)
+	if err != nil {
+		return nil, fmt.Errorf("ReadWebhooks: %w", err)
+	}
+	var hooks []Webhook
+	err = json.Unmarshal(contents, &hooks)
+	if err != nil {
+		return nil, fmt.Errorf("ReadWebhooks: %s: %w", fpath, err)
+	}
+	return hooks, nil
+}
+
+// webhookEvent is the body of a delivery.
+type webhookEvent struct {
+	// ID is the id of the journal record of the change, it is the same for
+	// every webhook the change is delivered to.
+	ID        string    `json:"id"`
+	Operation string    `json:"operation"`
+	Action    string    `json:"action,omitempty"`
+	Time      time.Time `json:"time"`
+	Client    string    `json:"client"`
+	Before    []string  `json:"before,omitempty"`
+	After     []string  `json:"after,omitempty"`
+	Detail    string    `json:"detail,omitempty"`
+	// UID is the uid of the entry at After, if it has one.
+	UID string `json:"uid,omitempty"`
+}
+
+// webhookDelivery is stored as .archive/webhooks/<record id>-<n>.json until
+// it is delivered, so deliveries are not lost when the service restarts.
+type webhookDelivery struct {
+	// Hook is the index of the webhook in the configuration the delivery was
+	// queued for, URL has to match it still or the delivery is dropped.
+	Hook      int             `json:"hook"`
+	URL       string          `json:"url"`
+	Operation string          `json:"operation"`
+	Body      json.RawMessage `json:"body"`
+	Attempts  int             `json:"attempts,omitempty"`
+	// Next is when the delivery is attempted again after a failed attempt.
+	Next time.Time `json:"next,omitempty"`
+}
+
+// webhooks queues and delivers the changes the webhooks subscribed to.
+// Deliveries to the same url are made in the order of the changes, a failed
+// delivery holds back the ones after it until it succeeds or is dropped.
+type webhooks struct {
+	dir    string
+	hooks  []Webhook
+	client *http.Client
+	// wake is signaled when a delivery is queued
+	wake chan struct{}
+}
+
+func newWebhooks(root string, hooks []Webhook) *webhooks {
+	return &webhooks{
+		dir:    filepath.Join(root, stateDir, "webhooks"),
+		hooks:  hooks,
+		client: &http.Client{Timeout: webhookTimeout},
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// enqueue queues a delivery of record to every webhook that subscribed to it,
+// the change has already been made so failures are only logged.
+func (w *webhooks) enqueue(record journalRecord, uid string) {
+	var body []byte
+	for i, hook := range w.hooks {
+		if !hook.matches(record) {
+			continue
+		}
+		if body == nil {
+			var err error
+			body, err = json.Marshal(webhookEvent{
+				ID:        record.ID,
+				Operation: record.Operation,
+				Action:    record.Action,
+				Time:      record.Time,
+				Client:    record.Client,
+				Before:    record.Before,
+				After:     record.After,
+				Detail:    record.Detail,
+				UID:       uid,
+			})
+			if err != nil {
+				slog.Warn("failed to encode webhook event", "err", err)
+				return
+			}
+		}
+		err := w.write(fmt.Sprintf("%s-%d", record.ID, i), webhookDelivery{
+			Hook:      i,
+			URL:       hook.URL,
+			Operation: record.Operation,
+			Body:      body,
+		})
+		if err != nil {
+			slog.Warn("failed to queue webhook delivery", "url", hook.URL, "err", err)
+		}
+	}
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// write stores a delivery, it is written to a temporary file first so a
+// partially written delivery is never read.
+func (w *webhooks) write(id string, delivery webhookDelivery) error {
+	contents, err := json.Marshal(delivery)
+	if err != nil {
+		return fmt.Errorf("webhooks.write: %w", err)
+	}
+	err = os.MkdirAll(w.dir, 0777)
+	if err != nil {
+		return fmt.Errorf("webhooks.write: %w", err)
+	}
+	tmp, err := os.CreateTemp(w.dir, id+"-*.tmp")
+	if err != nil {
+		return fmt.Errorf("webhooks.write: %w", err)
+	}
+	_, err = tmp.Write(contents)
+	closeErr := tmp.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filepath.Join(w.dir, id+".json"))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("webhooks.write: %w", err)
+	}
+	return nil
+}
+
+// pending returns the ids of the queued deliveries, oldest first.
+func (w *webhooks) pending() ([]string, error) {
+	entries, err := os.ReadDir(w.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("webhooks.pending: %w", err)
+	}
+	var ids []string
+	for _, e := range entries {
+		if id, ok := strings.CutSuffix(e.Name(), ".json"); ok {
+			ids = append(ids, id)
+		}
+	}
+	// record ids are base 36 timestamps, longer ones are always later, the
+	// deliveries of the same record are ordered by the index of their webhook
+	slices.SortFunc(ids, func(a, b string) int {
+		aRecord, aHook := splitDeliveryID(a)
+		bRecord, bHook := splitDeliveryID(b)
+		return cmp.Or(
+			cmp.Compare(len(aRecord), len(bRecord)),
+			strings.Compare(aRecord, bRecord),
+			cmp.Compare(aHook, bHook),
+		)
+	})
+	return ids, nil
+}
+
+// splitDeliveryID splits the id of a delivery into the id of its record and
+// the index of its webhook.
+func splitDeliveryID(id string) (string, int) {
+	record, hook, ok := strings.Cut(id, "-")
+	if !ok {
+		return id, 0
+	}
+	i, err := strconv.Atoi(hook)
+	if err != nil {
+		return id, 0
+	}
+	return record, i
+}
+
+// send posts a delivery to its webhook.
+func (w *webhooks) send(id string, hook Webhook, delivery webhookDelivery) error {
+	req, err := http.NewRequest(http.MethodPost, hook.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return fmt.Errorf("webhooks.send: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.Operation)
+	req.Header.Set(DeliveryHeader, id)
+	if hook.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(hook.Secret))
+		mac.Write(delivery.Body)
+		req.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	res, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhooks.send: %w", err)
+	}
+	res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhooks.send: %s responded with %s", hook.URL, res.Status)
+	}
+	return nil
+}
+
+// deliver attempts every queued delivery that is due.
+func (w *webhooks) deliver() {
+	ids, err := w.pending()
+	if err != nil {
+		slog.Warn("failed to read webhook deliveries", "err", err)
+		return
+	}
+	now := time.Now()
+	held := make(map[string]bool)
+	for _, id := range ids {
+		fpath := filepath.Join(w.dir, id+".json")
+		contents, err := os.ReadFile(fpath)
+		if err != nil {
+			slog.Warn("failed to read webhook delivery", "id", id, "err", err)
+			continue
+		}
+		var delivery webhookDelivery
+		err = json.Unmarshal(contents, &delivery)
+		if err != nil {
+			slog.Warn("dropping invalid webhook delivery", "id", id, "err", err)
+			os.Remove(fpath)
+			continue
+		}
+		// several webhooks can share a url with different events or secrets,
+		// so the delivery belongs to the webhook it was queued for
+		if delivery.Hook < 0 || delivery.Hook >= len(w.hooks) || w.hooks[delivery.Hook].URL != delivery.URL {
+			slog.Warn("dropping webhook delivery to a webhook that is no longer configured", "id", id, "url", delivery.URL)
+			os.Remove(fpath)
+			continue
+		}
+		if held[delivery.URL] || delivery.Next.After(now) {
+			held[delivery.URL] = true
+			continue
+		}
+
+		err = w.send(id, w.hooks[delivery.Hook], delivery)
+		if err == nil {
+			slog.Debug("delivered webhook", "id", id, "url", delivery.URL)
+			os.Remove(fpath)
+			continue
+		}
+		delivery.Attempts++
+		if delivery.Attempts >= maxWebhookAttempts {
+			slog.Warn("dropping webhook delivery after too many attempts", "id", id, "url", delivery.URL, "err", err)
+			os.Remove(fpath)
+			continue
+		}
+		slog.Warn("failed to deliver webhook, retrying later", "id", id, "url", delivery.URL, "attempts", delivery.Attempts, "err", err)
+		delivery.Next = now.Add(min(webhookRetryDelay<<(delivery.Attempts-1), maxWebhookRetryDelay))
+		held[delivery.URL] = true
+		err = w.write(id, delivery)
+		if err != nil {
+			slog.Warn("failed to store webhook delivery", "id", id, "err", err)
+		}
+	}
+}
+
+// deliverContinuously delivers queued deliveries as they come in, and
+// retries failed ones, until stop is closed.
+func (w *webhooks) deliverContinuously(stop <-chan struct{}) {
+	w.deliver()
+	ticker := time.NewTicker(webhookCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-w.wake:
+			w.deliver()
+		case <-ticker.C:
+			w.deliver()
+		}
+	}
+}
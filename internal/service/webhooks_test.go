@@ -0,0 +1,49 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestWebhooksPending(t *testing.T) {
+	tests := []struct {
+		name string
+		// want are the ids of the deliveries in the order they are
+		// delivered
+		want []string
+	}{
+		{"Records", []string{"abc-0", "abd-0", "abe-0"}},
+		{"Hooks", []string{"abc-0", "abc-1", "abc-2"}},
+		{"TwoDigitHooks", []string{"abc-2", "abc-10", "abd-1", "abd-10"}},
+		{"LongerRecord", []string{"zzz-10", "1000-0", "1000-1"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := newWebhooks(t.TempDir(), nil)
+			err := os.MkdirAll(w.dir, 0777)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, id := range test.want {
+				err = os.WriteFile(filepath.Join(w.dir, id+".json"), []byte("{}"), 0666)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			// temporary files of deliveries being written are left out
+			err = os.WriteFile(filepath.Join(w.dir, "abc-0-123.tmp"), nil, 0666)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := w.pending()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, test.want) {
+				t.Errorf("pending is %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateRequest, CreateResponse, DeleteRequest, DeleteResponse, MoveRequest, MoveResponse, ReadRequest, ReadResponse, SearchRequest, SearchResponse } from "./api_pb.js";
+import { AddImageRequest, AddImageResponse, AdjustQuantityRequest, AdjustQuantityResponse, CheckInRequest, CheckInResponse, CheckOutRequest, CheckOutResponse, CreateRequest, CreateResponse, DeleteRequest, DeleteResponse, DownloadAttachmentRequest, DownloadAttachmentResponse, DueMaintenanceRequest, DueMaintenanceResponse, EmptyTrashRequest, EmptyTrashResponse, FinishAuditRequest, FinishAuditResponse, HistoryRequest, HistoryResponse, ListAttachmentsRequest, ListAttachmentsResponse, ListCheckedOutRequest, ListCheckedOutResponse, ListRevisionsRequest, ListRevisionsResponse, ListTrashRequest, ListTrashResponse, LocateRequest, LocateResponse, LogMaintenanceRequest, LogMaintenanceResponse, LowStockRequest, LowStockResponse, MaintenanceHistoryRequest, MaintenanceHistoryResponse, MarkSeenRequest, MarkSeenResponse, MoveRequest, MoveResponse, QuantityHistoryRequest, QuantityHistoryResponse, ReadAtRequest, ReadAtResponse, ReadRequest, ReadResponse, RedoRequest, RedoResponse, RemoveImageRequest, RemoveImageResponse, ReorderImagesRequest, ReorderImagesResponse, ResolveIDRequest, ResolveIDResponse, RestoreRequest, RestoreResponse, SearchRequest, SearchResponse, SetPrimaryImageRequest, SetPrimaryImageResponse, StartAuditRequest, StartAuditResponse, UndoRequest, UndoResponse, UpcomingRequest, UpcomingResponse, UpdateRequest, UpdateResponse, UploadAttachmentRequest, UploadAttachmentResponse, WatchRequest, WatchResponse } from "./api_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -21,6 +21,24 @@ export const ArchiveService = {
       O: ReadResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc v1.ArchiveService.ResolveID
+     */
+    resolveID: {
+      name: "ResolveID",
+      I: ResolveIDRequest,
+      O: ResolveIDResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.Locate
+     */
+    locate: {
+      name: "Locate",
+      I: LocateRequest,
+      O: LocateResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc v1.ArchiveService.Create
      */
@@ -30,6 +48,168 @@ export const ArchiveService = {
       O: CreateResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc v1.ArchiveService.Update
+     */
+    update: {
+      name: "Update",
+      I: UpdateRequest,
+      O: UpdateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.AddImage
+     */
+    addImage: {
+      name: "AddImage",
+      I: AddImageRequest,
+      O: AddImageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.ReorderImages
+     */
+    reorderImages: {
+      name: "ReorderImages",
+      I: ReorderImagesRequest,
+      O: ReorderImagesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.SetPrimaryImage
+     */
+    setPrimaryImage: {
+      name: "SetPrimaryImage",
+      I: SetPrimaryImageRequest,
+      O: SetPrimaryImageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.RemoveImage
+     */
+    removeImage: {
+      name: "RemoveImage",
+      I: RemoveImageRequest,
+      O: RemoveImageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.ListAttachments
+     */
+    listAttachments: {
+      name: "ListAttachments",
+      I: ListAttachmentsRequest,
+      O: ListAttachmentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.UploadAttachment
+     */
+    uploadAttachment: {
+      name: "UploadAttachment",
+      I: UploadAttachmentRequest,
+      O: UploadAttachmentResponse,
+      kind: MethodKind.ClientStreaming,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.DownloadAttachment
+     */
+    downloadAttachment: {
+      name: "DownloadAttachment",
+      I: DownloadAttachmentRequest,
+      O: DownloadAttachmentResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.AdjustQuantity
+     */
+    adjustQuantity: {
+      name: "AdjustQuantity",
+      I: AdjustQuantityRequest,
+      O: AdjustQuantityResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.QuantityHistory
+     */
+    quantityHistory: {
+      name: "QuantityHistory",
+      I: QuantityHistoryRequest,
+      O: QuantityHistoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.LowStock
+     */
+    lowStock: {
+      name: "LowStock",
+      I: LowStockRequest,
+      O: LowStockResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.Upcoming
+     */
+    upcoming: {
+      name: "Upcoming",
+      I: UpcomingRequest,
+      O: UpcomingResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.LogMaintenance
+     */
+    logMaintenance: {
+      name: "LogMaintenance",
+      I: LogMaintenanceRequest,
+      O: LogMaintenanceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.MaintenanceHistory
+     */
+    maintenanceHistory: {
+      name: "MaintenanceHistory",
+      I: MaintenanceHistoryRequest,
+      O: MaintenanceHistoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.DueMaintenance
+     */
+    dueMaintenance: {
+      name: "DueMaintenance",
+      I: DueMaintenanceRequest,
+      O: DueMaintenanceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.CheckOut
+     */
+    checkOut: {
+      name: "CheckOut",
+      I: CheckOutRequest,
+      O: CheckOutResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.CheckIn
+     */
+    checkIn: {
+      name: "CheckIn",
+      I: CheckInRequest,
+      O: CheckInResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.ListCheckedOut
+     */
+    listCheckedOut: {
+      name: "ListCheckedOut",
+      I: ListCheckedOutRequest,
+      O: ListCheckedOutResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc v1.ArchiveService.Move
      */
@@ -48,6 +228,33 @@ export const ArchiveService = {
       O: DeleteResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc v1.ArchiveService.ListTrash
+     */
+    listTrash: {
+      name: "ListTrash",
+      I: ListTrashRequest,
+      O: ListTrashResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.Restore
+     */
+    restore: {
+      name: "Restore",
+      I: RestoreRequest,
+      O: RestoreResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.EmptyTrash
+     */
+    emptyTrash: {
+      name: "EmptyTrash",
+      I: EmptyTrashRequest,
+      O: EmptyTrashResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc v1.ArchiveService.Search
      */
@@ -57,6 +264,87 @@ export const ArchiveService = {
       O: SearchResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc v1.ArchiveService.History
+     */
+    history: {
+      name: "History",
+      I: HistoryRequest,
+      O: HistoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.Undo
+     */
+    undo: {
+      name: "Undo",
+      I: UndoRequest,
+      O: UndoResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.Redo
+     */
+    redo: {
+      name: "Redo",
+      I: RedoRequest,
+      O: RedoResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.ListRevisions
+     */
+    listRevisions: {
+      name: "ListRevisions",
+      I: ListRevisionsRequest,
+      O: ListRevisionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.ReadAt
+     */
+    readAt: {
+      name: "ReadAt",
+      I: ReadAtRequest,
+      O: ReadAtResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.StartAudit
+     */
+    startAudit: {
+      name: "StartAudit",
+      I: StartAuditRequest,
+      O: StartAuditResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.MarkSeen
+     */
+    markSeen: {
+      name: "MarkSeen",
+      I: MarkSeenRequest,
+      O: MarkSeenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.FinishAudit
+     */
+    finishAudit: {
+      name: "FinishAudit",
+      I: FinishAuditRequest,
+      O: FinishAuditResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc v1.ArchiveService.Watch
+     */
+    watch: {
+      name: "Watch",
+      I: WatchRequest,
+      O: WatchResponse,
+      kind: MethodKind.ServerStreaming,
+    },
   }
 } as const;
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { FieldMask, Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 
 /**
  * @generated from enum v1.ImageFormat
@@ -38,6 +38,187 @@ proto3.util.setEnumType(ImageFormat, "v1.ImageFormat", [
   { no: 3, name: "SVG" },
 ]);
 
+/**
+ * ImageRef references an image served over plain HTTP instead of inlining it
+ *
+ * @generated from message v1.ImageRef
+ */
+export class ImageRef extends Message<ImageRef> {
+  /**
+   * hash is the hex encoded sha256 hash of the image contents
+   *
+   * @generated from field: string hash = 1;
+   */
+  hash = "";
+
+  /**
+   * @generated from field: v1.ImageFormat format = 2;
+   */
+  format = ImageFormat.JPG;
+
+  /**
+   * url is the path the image is served at, relative to the server's base url
+   *
+   * @generated from field: string url = 3;
+   */
+  url = "";
+
+  /**
+   * @generated from field: v1.ImageRef.Thumbnails thumbnails = 4;
+   */
+  thumbnails?: ImageRef_Thumbnails;
+
+  constructor(data?: PartialMessage<ImageRef>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ImageRef";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "format", kind: "enum", T: proto3.getEnumType(ImageFormat) },
+    { no: 3, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "thumbnails", kind: "message", T: ImageRef_Thumbnails },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImageRef {
+    return new ImageRef().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImageRef {
+    return new ImageRef().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImageRef {
+    return new ImageRef().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImageRef | PlainMessage<ImageRef> | undefined, b: ImageRef | PlainMessage<ImageRef> | undefined): boolean {
+    return proto3.util.equals(ImageRef, a, b);
+  }
+}
+
+/**
+ * Thumbnails holds the paths that scaled down versions of the image are served at, relative
+ * to the server's base url, the images fit into squares of 64, 256 and 1024 pixels respectively
+ *
+ * @generated from message v1.ImageRef.Thumbnails
+ */
+export class ImageRef_Thumbnails extends Message<ImageRef_Thumbnails> {
+  /**
+   * @generated from field: string small = 1;
+   */
+  small = "";
+
+  /**
+   * @generated from field: string medium = 2;
+   */
+  medium = "";
+
+  /**
+   * @generated from field: string large = 3;
+   */
+  large = "";
+
+  constructor(data?: PartialMessage<ImageRef_Thumbnails>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ImageRef.Thumbnails";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "small", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "medium", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "large", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImageRef_Thumbnails {
+    return new ImageRef_Thumbnails().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImageRef_Thumbnails {
+    return new ImageRef_Thumbnails().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImageRef_Thumbnails {
+    return new ImageRef_Thumbnails().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImageRef_Thumbnails | PlainMessage<ImageRef_Thumbnails> | undefined, b: ImageRef_Thumbnails | PlainMessage<ImageRef_Thumbnails> | undefined): boolean {
+    return proto3.util.equals(ImageRef_Thumbnails, a, b);
+  }
+}
+
+/**
+ * FieldValue is the value of a structured field of an entry
+ *
+ * @generated from message v1.FieldValue
+ */
+export class FieldValue extends Message<FieldValue> {
+  /**
+   * @generated from oneof v1.FieldValue.kind
+   */
+  kind: {
+    /**
+     * @generated from field: string text = 1;
+     */
+    value: string;
+    case: "text";
+  } | {
+    /**
+     * @generated from field: double number = 2;
+     */
+    value: number;
+    case: "number";
+  } | {
+    /**
+     * @generated from field: bool boolean = 3;
+     */
+    value: boolean;
+    case: "boolean";
+  } | {
+    /**
+     * date is formatted as YYYY-MM-DD
+     *
+     * @generated from field: string date = 4;
+     */
+    value: string;
+    case: "date";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<FieldValue>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.FieldValue";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "kind" },
+    { no: 2, name: "number", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, oneof: "kind" },
+    { no: 3, name: "boolean", kind: "scalar", T: 8 /* ScalarType.BOOL */, oneof: "kind" },
+    { no: 4, name: "date", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "kind" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FieldValue {
+    return new FieldValue().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FieldValue {
+    return new FieldValue().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FieldValue {
+    return new FieldValue().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FieldValue | PlainMessage<FieldValue> | undefined, b: FieldValue | PlainMessage<FieldValue> | undefined): boolean {
+    return proto3.util.equals(FieldValue, a, b);
+  }
+}
+
 /**
  * EntryMetadata describes the metadata present in both items and containers
  *
@@ -60,6 +241,9 @@ export class EntryMetadata extends Message<EntryMetadata> {
   description?: string;
 
   /**
+   * image is only used to upload the primary image in Create and Update, entries that are read
+   * reference their images through image_ref and images instead
+   *
    * @generated from field: optional bytes image = 4;
    */
   image?: Uint8Array;
@@ -69,6 +253,59 @@ export class EntryMetadata extends Message<EntryMetadata> {
    */
   imageFormat?: ImageFormat;
 
+  /**
+   * image_ref references the primary image, it is the same as the first element of images
+   *
+   * @generated from field: optional v1.ImageRef image_ref = 6;
+   */
+  imageRef?: ImageRef;
+
+  /**
+   * images references every image of the entry in order, starting with the primary image, use
+   * AddImage, ReorderImages, SetPrimaryImage and RemoveImage to change them
+   *
+   * @generated from field: repeated v1.ImageRef images = 7;
+   */
+  images: ImageRef[] = [];
+
+  /**
+   * fields holds structured fields like the price or brand of an entry, keys may only contain lowercase
+   * letters, digits and underscores
+   *
+   * the well known fields quantity, min_quantity and price must be numbers, purchase_date, expires,
+   * warranty_until and next_maintenance must be dates and unit, serial_number, brand and
+   * maintenance_rule must be text, any other field can have any type
+   *
+   * quantity should be changed through AdjustQuantity so the change is recorded, entries whose quantity
+   * is below their min_quantity are listed by LowStock
+   *
+   * expires, warranty_until and next_maintenance are deadlines that are listed by Upcoming
+   *
+   * maintenance_rule is a recurrence rule like FREQ=MONTHLY;INTERVAL=3 that sets when next_maintenance
+   * is due after LogMaintenance, FREQ can be DAILY, WEEKLY, MONTHLY or YEARLY and INTERVAL is optional
+   *
+   * @generated from field: map<string, v1.FieldValue> fields = 8;
+   */
+  fields: { [key: string]: FieldValue } = {};
+
+  /**
+   * uid is a unique id of the entry that stays the same when it is moved or renamed, it is assigned by
+   * the service and cannot be changed
+   *
+   * every path in a request can start with the uid of an entry prefixed with @ instead of the path to
+   * that entry, like [@k7vq2xj4m3nd6pby, id_3.item], see ResolveID
+   *
+   * @generated from field: string uid = 9;
+   */
+  uid = "";
+
+  /**
+   * loan is set while the item is lent to someone, see CheckOut, it cannot be changed through Update
+   *
+   * @generated from field: optional v1.Loan loan = 10;
+   */
+  loan?: Loan;
+
   constructor(data?: PartialMessage<EntryMetadata>) {
     super();
     proto3.util.initPartial(data, this);
@@ -82,6 +319,11 @@ export class EntryMetadata extends Message<EntryMetadata> {
     { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "image", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
     { no: 5, name: "image_format", kind: "enum", T: proto3.getEnumType(ImageFormat), opt: true },
+    { no: 6, name: "image_ref", kind: "message", T: ImageRef, opt: true },
+    { no: 7, name: "images", kind: "message", T: ImageRef, repeated: true },
+    { no: 8, name: "fields", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: FieldValue} },
+    { no: 9, name: "uid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "loan", kind: "message", T: Loan, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EntryMetadata {
@@ -101,6 +343,225 @@ export class EntryMetadata extends Message<EntryMetadata> {
   }
 }
 
+/**
+ * Loan describes who an item is lent to
+ *
+ * @generated from message v1.Loan
+ */
+export class Loan extends Message<Loan> {
+  /**
+   * @generated from field: string borrower = 1;
+   */
+  borrower = "";
+
+  /**
+   * since is when the item was checked out
+   *
+   * @generated from field: google.protobuf.Timestamp since = 2;
+   */
+  since?: Timestamp;
+
+  /**
+   * due is the date the item should be returned by formatted as YYYY-MM-DD, it is empty if there is
+   * no due date
+   *
+   * @generated from field: string due = 3;
+   */
+  due = "";
+
+  /**
+   * @generated from field: string note = 4;
+   */
+  note = "";
+
+  constructor(data?: PartialMessage<Loan>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Loan";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "borrower", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "since", kind: "message", T: Timestamp },
+    { no: 3, name: "due", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Loan {
+    return new Loan().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Loan {
+    return new Loan().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Loan {
+    return new Loan().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Loan | PlainMessage<Loan> | undefined, b: Loan | PlainMessage<Loan> | undefined): boolean {
+    return proto3.util.equals(Loan, a, b);
+  }
+}
+
+/**
+ * EntryError is attached as an error detail to errors caused by a specific entry
+ *
+ * @generated from message v1.EntryError
+ */
+export class EntryError extends Message<EntryError> {
+  /**
+   * @generated from field: v1.EntryError.Reason reason = 1;
+   */
+  reason = EntryError_Reason.UNKNOWN;
+
+  /**
+   * path of the entry that caused the error, this follows the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 2;
+   */
+  path: string[] = [];
+
+  constructor(data?: PartialMessage<EntryError>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.EntryError";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "reason", kind: "enum", T: proto3.getEnumType(EntryError_Reason) },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EntryError {
+    return new EntryError().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EntryError {
+    return new EntryError().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EntryError {
+    return new EntryError().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EntryError | PlainMessage<EntryError> | undefined, b: EntryError | PlainMessage<EntryError> | undefined): boolean {
+    return proto3.util.equals(EntryError, a, b);
+  }
+}
+
+/**
+ * @generated from enum v1.EntryError.Reason
+ */
+export enum EntryError_Reason {
+  /**
+   * @generated from enum value: UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * the entry at path does not exist
+   *
+   * @generated from enum value: NOT_FOUND = 1;
+   */
+  NOT_FOUND = 1,
+
+  /**
+   * an entry already exists at path
+   *
+   * @generated from enum value: ALREADY_EXISTS = 2;
+   */
+  ALREADY_EXISTS = 2,
+
+  /**
+   * path does not follow the path convention described in ReadRequest
+   *
+   * @generated from enum value: INVALID_PATH = 3;
+   */
+  INVALID_PATH = 3,
+
+  /**
+   * path leads outside of the archive or to an entry that cannot be modified
+   *
+   * @generated from enum value: PERMISSION_DENIED = 4;
+   */
+  PERMISSION_DENIED = 4,
+
+  /**
+   * a container cannot be moved into itself or one of its children
+   *
+   * @generated from enum value: MOVE_INTO_SELF = 5;
+   */
+  MOVE_INTO_SELF = 5,
+
+  /**
+   * the entry does not have an image with the requested hash
+   *
+   * @generated from enum value: IMAGE_NOT_FOUND = 6;
+   */
+  IMAGE_NOT_FOUND = 6,
+
+  /**
+   * the entry does not have an attachment with the requested name
+   *
+   * @generated from enum value: ATTACHMENT_NOT_FOUND = 7;
+   */
+  ATTACHMENT_NOT_FOUND = 7,
+
+  /**
+   * the entry already has an attachment with the requested name
+   *
+   * @generated from enum value: ATTACHMENT_ALREADY_EXISTS = 8;
+   */
+  ATTACHMENT_ALREADY_EXISTS = 8,
+
+  /**
+   * the quantity of the entry would drop below zero
+   *
+   * @generated from enum value: INSUFFICIENT_QUANTITY = 9;
+   */
+  INSUFFICIENT_QUANTITY = 9,
+
+  /**
+   * the entry was changed since the change that is being undone or redone was made
+   *
+   * @generated from enum value: DIVERGED = 10;
+   */
+  DIVERGED = 10,
+
+  /**
+   * the item is already checked out
+   *
+   * @generated from enum value: CHECKED_OUT = 11;
+   */
+  CHECKED_OUT = 11,
+
+  /**
+   * the item is not checked out
+   *
+   * @generated from enum value: NOT_CHECKED_OUT = 12;
+   */
+  NOT_CHECKED_OUT = 12,
+}
+// Retrieve enum metadata with: proto3.getEnumType(EntryError_Reason)
+proto3.util.setEnumType(EntryError_Reason, "v1.EntryError.Reason", [
+  { no: 0, name: "UNKNOWN" },
+  { no: 1, name: "NOT_FOUND" },
+  { no: 2, name: "ALREADY_EXISTS" },
+  { no: 3, name: "INVALID_PATH" },
+  { no: 4, name: "PERMISSION_DENIED" },
+  { no: 5, name: "MOVE_INTO_SELF" },
+  { no: 6, name: "IMAGE_NOT_FOUND" },
+  { no: 7, name: "ATTACHMENT_NOT_FOUND" },
+  { no: 8, name: "ATTACHMENT_ALREADY_EXISTS" },
+  { no: 9, name: "INSUFFICIENT_QUANTITY" },
+  { no: 10, name: "DIVERGED" },
+  { no: 11, name: "CHECKED_OUT" },
+  { no: 12, name: "NOT_CHECKED_OUT" },
+]);
+
 /**
  * Read
  *
@@ -239,359 +700,3903 @@ export class ReadResponse_Children extends Message<ReadResponse_Children> {
 }
 
 /**
- * Create creates a container or item
+ * ResolveID looks up the current path of the entry with a uid
  *
- * @generated from message v1.CreateRequest
+ * @generated from message v1.ResolveIDRequest
  */
-export class CreateRequest extends Message<CreateRequest> {
-  /**
-   * @generated from field: v1.EntryMetadata metadata = 1;
-   */
-  metadata?: EntryMetadata;
-
-  /**
-   * this should follow the same convention as the path in ReadRequest
-   *
-   * @generated from field: repeated string path = 2;
-   */
-  path: string[] = [];
-
+export class ResolveIDRequest extends Message<ResolveIDRequest> {
   /**
-   * create_container will create a container instead of an item if true
+   * uid is the uid of the entry, without the @ prefix used in paths
    *
-   * @generated from field: bool create_container = 3;
+   * @generated from field: string uid = 1;
    */
-  createContainer = false;
+  uid = "";
 
-  constructor(data?: PartialMessage<CreateRequest>) {
+  constructor(data?: PartialMessage<ResolveIDRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.CreateRequest";
+  static readonly typeName = "v1.ResolveIDRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "metadata", kind: "message", T: EntryMetadata },
-    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 3, name: "create_container", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "uid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateRequest {
-    return new CreateRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveIDRequest {
+    return new ResolveIDRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateRequest {
-    return new CreateRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResolveIDRequest {
+    return new ResolveIDRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateRequest {
-    return new CreateRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResolveIDRequest {
+    return new ResolveIDRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateRequest | PlainMessage<CreateRequest> | undefined, b: CreateRequest | PlainMessage<CreateRequest> | undefined): boolean {
-    return proto3.util.equals(CreateRequest, a, b);
+  static equals(a: ResolveIDRequest | PlainMessage<ResolveIDRequest> | undefined, b: ResolveIDRequest | PlainMessage<ResolveIDRequest> | undefined): boolean {
+    return proto3.util.equals(ResolveIDRequest, a, b);
   }
 }
 
 /**
- * @generated from message v1.CreateResponse
+ * @generated from message v1.ResolveIDResponse
  */
-export class CreateResponse extends Message<CreateResponse> {
-  constructor(data?: PartialMessage<CreateResponse>) {
+export class ResolveIDResponse extends Message<ResolveIDResponse> {
+  /**
+   * this follows the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  constructor(data?: PartialMessage<ResolveIDResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.CreateResponse";
+  static readonly typeName = "v1.ResolveIDResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateResponse {
-    return new CreateResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveIDResponse {
+    return new ResolveIDResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateResponse {
-    return new CreateResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResolveIDResponse {
+    return new ResolveIDResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateResponse {
-    return new CreateResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResolveIDResponse {
+    return new ResolveIDResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateResponse | PlainMessage<CreateResponse> | undefined, b: CreateResponse | PlainMessage<CreateResponse> | undefined): boolean {
-    return proto3.util.equals(CreateResponse, a, b);
+  static equals(a: ResolveIDResponse | PlainMessage<ResolveIDResponse> | undefined, b: ResolveIDResponse | PlainMessage<ResolveIDResponse> | undefined): boolean {
+    return proto3.util.equals(ResolveIDResponse, a, b);
   }
 }
 
 /**
- * Move can move a container or an item
+ * Locate finds the entry whose label is in a photo by decoding the QR code or Code128 barcode on it,
+ * the code can hold the link to the entry from its label or just its uid
  *
- * @generated from message v1.MoveRequest
+ * @generated from message v1.LocateRequest
  */
-export class MoveRequest extends Message<MoveRequest> {
+export class LocateRequest extends Message<LocateRequest> {
   /**
-   * this should follow the same convention as the path in ReadRequest
-   *
-   * @generated from field: repeated string src = 1;
+   * @generated from field: bytes image = 1;
    */
-  src: string[] = [];
+  image = new Uint8Array(0);
 
   /**
-   * this should follow the same convention as the path in ReadRequest
+   * svg images are not supported, neither are images of more than 100 million pixels
    *
-   * @generated from field: repeated string dest = 2;
+   * @generated from field: v1.ImageFormat format = 2;
    */
-  dest: string[] = [];
+  format = ImageFormat.JPG;
 
-  constructor(data?: PartialMessage<MoveRequest>) {
+  constructor(data?: PartialMessage<LocateRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.MoveRequest";
+  static readonly typeName = "v1.LocateRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "src", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 2, name: "dest", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 1, name: "image", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "format", kind: "enum", T: proto3.getEnumType(ImageFormat) },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MoveRequest {
-    return new MoveRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LocateRequest {
+    return new LocateRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MoveRequest {
-    return new MoveRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LocateRequest {
+    return new LocateRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MoveRequest {
-    return new MoveRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LocateRequest {
+    return new LocateRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: MoveRequest | PlainMessage<MoveRequest> | undefined, b: MoveRequest | PlainMessage<MoveRequest> | undefined): boolean {
-    return proto3.util.equals(MoveRequest, a, b);
+  static equals(a: LocateRequest | PlainMessage<LocateRequest> | undefined, b: LocateRequest | PlainMessage<LocateRequest> | undefined): boolean {
+    return proto3.util.equals(LocateRequest, a, b);
   }
 }
 
 /**
- * @generated from message v1.MoveResponse
+ * @generated from message v1.LocateResponse
  */
-export class MoveResponse extends Message<MoveResponse> {
-  constructor(data?: PartialMessage<MoveResponse>) {
+export class LocateResponse extends Message<LocateResponse> {
+  /**
+   * code is the text decoded from the label
+   *
+   * @generated from field: string code = 1;
+   */
+  code = "";
+
+  /**
+   * this follows the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 2;
+   */
+  path: string[] = [];
+
+  /**
+   * @generated from field: v1.ReadResponse entry = 3;
+   */
+  entry?: ReadResponse;
+
+  constructor(data?: PartialMessage<LocateResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.MoveResponse";
+  static readonly typeName = "v1.LocateResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "entry", kind: "message", T: ReadResponse },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MoveResponse {
-    return new MoveResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LocateResponse {
+    return new LocateResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MoveResponse {
-    return new MoveResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LocateResponse {
+    return new LocateResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MoveResponse {
-    return new MoveResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LocateResponse {
+    return new LocateResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: MoveResponse | PlainMessage<MoveResponse> | undefined, b: MoveResponse | PlainMessage<MoveResponse> | undefined): boolean {
-    return proto3.util.equals(MoveResponse, a, b);
+  static equals(a: LocateResponse | PlainMessage<LocateResponse> | undefined, b: LocateResponse | PlainMessage<LocateResponse> | undefined): boolean {
+    return proto3.util.equals(LocateResponse, a, b);
   }
 }
 
 /**
- * Delete can delete a container or an item
+ * Create creates a container or item
  *
- * @generated from message v1.DeleteRequest
+ * @generated from message v1.CreateRequest
  */
-export class DeleteRequest extends Message<DeleteRequest> {
+export class CreateRequest extends Message<CreateRequest> {
+  /**
+   * @generated from field: v1.EntryMetadata metadata = 1;
+   */
+  metadata?: EntryMetadata;
+
   /**
    * this should follow the same convention as the path in ReadRequest
    *
-   * @generated from field: repeated string path = 1;
+   * @generated from field: repeated string path = 2;
    */
   path: string[] = [];
 
-  constructor(data?: PartialMessage<DeleteRequest>) {
+  /**
+   * create_container will create a container instead of an item if true
+   *
+   * @generated from field: bool create_container = 3;
+   */
+  createContainer = false;
+
+  constructor(data?: PartialMessage<CreateRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.DeleteRequest";
+  static readonly typeName = "v1.CreateRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 1, name: "metadata", kind: "message", T: EntryMetadata },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "create_container", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRequest {
-    return new DeleteRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateRequest {
+    return new CreateRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRequest {
-    return new DeleteRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateRequest {
+    return new CreateRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRequest {
-    return new DeleteRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateRequest {
+    return new CreateRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteRequest | PlainMessage<DeleteRequest> | undefined, b: DeleteRequest | PlainMessage<DeleteRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteRequest, a, b);
+  static equals(a: CreateRequest | PlainMessage<CreateRequest> | undefined, b: CreateRequest | PlainMessage<CreateRequest> | undefined): boolean {
+    return proto3.util.equals(CreateRequest, a, b);
   }
 }
 
 /**
- * @generated from message v1.DeleteResponse
+ * @generated from message v1.CreateResponse
  */
-export class DeleteResponse extends Message<DeleteResponse> {
-  constructor(data?: PartialMessage<DeleteResponse>) {
+export class CreateResponse extends Message<CreateResponse> {
+  constructor(data?: PartialMessage<CreateResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.DeleteResponse";
+  static readonly typeName = "v1.CreateResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteResponse {
-    return new DeleteResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateResponse {
+    return new CreateResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteResponse {
-    return new DeleteResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateResponse {
+    return new CreateResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteResponse {
-    return new DeleteResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateResponse {
+    return new CreateResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteResponse | PlainMessage<DeleteResponse> | undefined, b: DeleteResponse | PlainMessage<DeleteResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteResponse, a, b);
+  static equals(a: CreateResponse | PlainMessage<CreateResponse> | undefined, b: CreateResponse | PlainMessage<CreateResponse> | undefined): boolean {
+    return proto3.util.equals(CreateResponse, a, b);
   }
 }
 
 /**
- * Search
+ * Update changes the metadata of an existing container or item
  *
- * @generated from message v1.SearchRequest
+ * @generated from message v1.UpdateRequest
  */
-export class SearchRequest extends Message<SearchRequest> {
+export class UpdateRequest extends Message<UpdateRequest> {
   /**
-   * @generated from field: string query = 1;
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
    */
-  query = "";
+  path: string[] = [];
 
-  constructor(data?: PartialMessage<SearchRequest>) {
+  /**
+   * @generated from field: v1.EntryMetadata metadata = 2;
+   */
+  metadata?: EntryMetadata;
+
+  /**
+   * update_mask lists the fields of metadata that should be changed, fields that are not listed are
+   * left untouched, an empty mask changes every field
+   *
+   * listing description or image while leaving them unset removes them from the entry, changing the
+   * id or tags renames the entry, image only replaces the primary image, the other images are kept
+   *
+   * fields replaces every field of the entry, to change a single field the other fields must be sent as well
+   *
+   * only id, tags, description, image, image_format and fields can be listed, image_format only
+   * together with image
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 3;
+   */
+  updateMask?: FieldMask;
+
+  constructor(data?: PartialMessage<UpdateRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.SearchRequest";
+  static readonly typeName = "v1.UpdateRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "metadata", kind: "message", T: EntryMetadata },
+    { no: 3, name: "update_mask", kind: "message", T: FieldMask },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchRequest {
-    return new SearchRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateRequest {
+    return new UpdateRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchRequest {
-    return new SearchRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateRequest {
+    return new UpdateRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchRequest {
-    return new SearchRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateRequest {
+    return new UpdateRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SearchRequest | PlainMessage<SearchRequest> | undefined, b: SearchRequest | PlainMessage<SearchRequest> | undefined): boolean {
-    return proto3.util.equals(SearchRequest, a, b);
+  static equals(a: UpdateRequest | PlainMessage<UpdateRequest> | undefined, b: UpdateRequest | PlainMessage<UpdateRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateRequest, a, b);
   }
 }
 
 /**
- * @generated from message v1.SearchResponse
+ * @generated from message v1.UpdateResponse
  */
-export class SearchResponse extends Message<SearchResponse> {
+export class UpdateResponse extends Message<UpdateResponse> {
   /**
-   * @generated from field: repeated v1.SearchResponse.Entry entries = 1;
+   * path is the path of the entry after the update, it only differs from the request path if the id
+   * or tags were changed
+   *
+   * @generated from field: repeated string path = 1;
    */
-  entries: SearchResponse_Entry[] = [];
+  path: string[] = [];
 
-  constructor(data?: PartialMessage<SearchResponse>) {
+  constructor(data?: PartialMessage<UpdateResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.SearchResponse";
+  static readonly typeName = "v1.UpdateResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "entries", kind: "message", T: SearchResponse_Entry, repeated: true },
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchResponse {
-    return new SearchResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateResponse {
+    return new UpdateResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchResponse {
-    return new SearchResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateResponse {
+    return new UpdateResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchResponse {
-    return new SearchResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateResponse {
+    return new UpdateResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SearchResponse | PlainMessage<SearchResponse> | undefined, b: SearchResponse | PlainMessage<SearchResponse> | undefined): boolean {
-    return proto3.util.equals(SearchResponse, a, b);
+  static equals(a: UpdateResponse | PlainMessage<UpdateResponse> | undefined, b: UpdateResponse | PlainMessage<UpdateResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateResponse, a, b);
   }
 }
 
 /**
- * @generated from message v1.SearchResponse.Entry
+ * AddImage adds an image to a container or item
+ *
+ * @generated from message v1.AddImageRequest
  */
-export class SearchResponse_Entry extends Message<SearchResponse_Entry> {
+export class AddImageRequest extends Message<AddImageRequest> {
   /**
+   * this should follow the same convention as the path in ReadRequest
+   *
    * @generated from field: repeated string path = 1;
    */
   path: string[] = [];
 
   /**
-   * @generated from field: v1.EntryMetadata meta = 2;
+   * @generated from field: bytes image = 2;
    */
-  meta?: EntryMetadata;
+  image = new Uint8Array(0);
 
-  constructor(data?: PartialMessage<SearchResponse_Entry>) {
+  /**
+   * @generated from field: v1.ImageFormat format = 3;
+   */
+  format = ImageFormat.JPG;
+
+  /**
+   * position is the index the image is inserted at, 0 makes it the primary image, the image is
+   * appended after the existing images if position is unset or past the end
+   *
+   * @generated from field: optional uint32 position = 4;
+   */
+  position?: number;
+
+  constructor(data?: PartialMessage<AddImageRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.SearchResponse.Entry";
+  static readonly typeName = "v1.AddImageRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 2, name: "meta", kind: "message", T: EntryMetadata },
+    { no: 2, name: "image", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "format", kind: "enum", T: proto3.getEnumType(ImageFormat) },
+    { no: 4, name: "position", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchResponse_Entry {
-    return new SearchResponse_Entry().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddImageRequest {
+    return new AddImageRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchResponse_Entry {
-    return new SearchResponse_Entry().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddImageRequest {
+    return new AddImageRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchResponse_Entry {
-    return new SearchResponse_Entry().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddImageRequest {
+    return new AddImageRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SearchResponse_Entry | PlainMessage<SearchResponse_Entry> | undefined, b: SearchResponse_Entry | PlainMessage<SearchResponse_Entry> | undefined): boolean {
-    return proto3.util.equals(SearchResponse_Entry, a, b);
+  static equals(a: AddImageRequest | PlainMessage<AddImageRequest> | undefined, b: AddImageRequest | PlainMessage<AddImageRequest> | undefined): boolean {
+    return proto3.util.equals(AddImageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.AddImageResponse
+ */
+export class AddImageResponse extends Message<AddImageResponse> {
+  /**
+   * @generated from field: v1.ImageRef image = 1;
+   */
+  image?: ImageRef;
+
+  constructor(data?: PartialMessage<AddImageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.AddImageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "image", kind: "message", T: ImageRef },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddImageResponse {
+    return new AddImageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddImageResponse {
+    return new AddImageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddImageResponse {
+    return new AddImageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddImageResponse | PlainMessage<AddImageResponse> | undefined, b: AddImageResponse | PlainMessage<AddImageResponse> | undefined): boolean {
+    return proto3.util.equals(AddImageResponse, a, b);
   }
 }
 
+/**
+ * ReorderImages changes the order of the images of a container or item
+ *
+ * @generated from message v1.ReorderImagesRequest
+ */
+export class ReorderImagesRequest extends Message<ReorderImagesRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * hashes lists the hashes of every image of the entry in the new order, the first image becomes
+   * the primary image
+   *
+   * @generated from field: repeated string hashes = 2;
+   */
+  hashes: string[] = [];
+
+  constructor(data?: PartialMessage<ReorderImagesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ReorderImagesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "hashes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReorderImagesRequest {
+    return new ReorderImagesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReorderImagesRequest {
+    return new ReorderImagesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReorderImagesRequest {
+    return new ReorderImagesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReorderImagesRequest | PlainMessage<ReorderImagesRequest> | undefined, b: ReorderImagesRequest | PlainMessage<ReorderImagesRequest> | undefined): boolean {
+    return proto3.util.equals(ReorderImagesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ReorderImagesResponse
+ */
+export class ReorderImagesResponse extends Message<ReorderImagesResponse> {
+  constructor(data?: PartialMessage<ReorderImagesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ReorderImagesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReorderImagesResponse {
+    return new ReorderImagesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReorderImagesResponse {
+    return new ReorderImagesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReorderImagesResponse {
+    return new ReorderImagesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReorderImagesResponse | PlainMessage<ReorderImagesResponse> | undefined, b: ReorderImagesResponse | PlainMessage<ReorderImagesResponse> | undefined): boolean {
+    return proto3.util.equals(ReorderImagesResponse, a, b);
+  }
+}
+
+/**
+ * SetPrimaryImage moves an image of a container or item to the front, the order of the other
+ * images is kept
+ *
+ * @generated from message v1.SetPrimaryImageRequest
+ */
+export class SetPrimaryImageRequest extends Message<SetPrimaryImageRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * @generated from field: string hash = 2;
+   */
+  hash = "";
+
+  constructor(data?: PartialMessage<SetPrimaryImageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.SetPrimaryImageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetPrimaryImageRequest {
+    return new SetPrimaryImageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetPrimaryImageRequest {
+    return new SetPrimaryImageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetPrimaryImageRequest {
+    return new SetPrimaryImageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetPrimaryImageRequest | PlainMessage<SetPrimaryImageRequest> | undefined, b: SetPrimaryImageRequest | PlainMessage<SetPrimaryImageRequest> | undefined): boolean {
+    return proto3.util.equals(SetPrimaryImageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.SetPrimaryImageResponse
+ */
+export class SetPrimaryImageResponse extends Message<SetPrimaryImageResponse> {
+  constructor(data?: PartialMessage<SetPrimaryImageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.SetPrimaryImageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetPrimaryImageResponse {
+    return new SetPrimaryImageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetPrimaryImageResponse {
+    return new SetPrimaryImageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetPrimaryImageResponse {
+    return new SetPrimaryImageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetPrimaryImageResponse | PlainMessage<SetPrimaryImageResponse> | undefined, b: SetPrimaryImageResponse | PlainMessage<SetPrimaryImageResponse> | undefined): boolean {
+    return proto3.util.equals(SetPrimaryImageResponse, a, b);
+  }
+}
+
+/**
+ * RemoveImage removes an image from a container or item
+ *
+ * @generated from message v1.RemoveImageRequest
+ */
+export class RemoveImageRequest extends Message<RemoveImageRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * @generated from field: string hash = 2;
+   */
+  hash = "";
+
+  constructor(data?: PartialMessage<RemoveImageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RemoveImageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveImageRequest {
+    return new RemoveImageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveImageRequest {
+    return new RemoveImageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveImageRequest {
+    return new RemoveImageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveImageRequest | PlainMessage<RemoveImageRequest> | undefined, b: RemoveImageRequest | PlainMessage<RemoveImageRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveImageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RemoveImageResponse
+ */
+export class RemoveImageResponse extends Message<RemoveImageResponse> {
+  constructor(data?: PartialMessage<RemoveImageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RemoveImageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveImageResponse {
+    return new RemoveImageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveImageResponse {
+    return new RemoveImageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveImageResponse {
+    return new RemoveImageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveImageResponse | PlainMessage<RemoveImageResponse> | undefined, b: RemoveImageResponse | PlainMessage<RemoveImageResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveImageResponse, a, b);
+  }
+}
+
+/**
+ * Attachment describes a file attached to a container or item, like a manual or a receipt
+ *
+ * @generated from message v1.Attachment
+ */
+export class Attachment extends Message<Attachment> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * mime_type is detected from the name of the attachment, or from its contents if the name has no
+   * known extension
+   *
+   * @generated from field: string mime_type = 2;
+   */
+  mimeType = "";
+
+  /**
+   * size is the size of the attachment in bytes
+   *
+   * @generated from field: int64 size = 3;
+   */
+  size = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp modified_at = 4;
+   */
+  modifiedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Attachment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Attachment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "modified_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Attachment {
+    return new Attachment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Attachment {
+    return new Attachment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Attachment {
+    return new Attachment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Attachment | PlainMessage<Attachment> | undefined, b: Attachment | PlainMessage<Attachment> | undefined): boolean {
+    return proto3.util.equals(Attachment, a, b);
+  }
+}
+
+/**
+ * ListAttachments lists the attachments of a container or item sorted by name
+ *
+ * @generated from message v1.ListAttachmentsRequest
+ */
+export class ListAttachmentsRequest extends Message<ListAttachmentsRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  constructor(data?: PartialMessage<ListAttachmentsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ListAttachmentsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAttachmentsRequest {
+    return new ListAttachmentsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAttachmentsRequest {
+    return new ListAttachmentsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAttachmentsRequest {
+    return new ListAttachmentsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAttachmentsRequest | PlainMessage<ListAttachmentsRequest> | undefined, b: ListAttachmentsRequest | PlainMessage<ListAttachmentsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAttachmentsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ListAttachmentsResponse
+ */
+export class ListAttachmentsResponse extends Message<ListAttachmentsResponse> {
+  /**
+   * @generated from field: repeated v1.Attachment attachments = 1;
+   */
+  attachments: Attachment[] = [];
+
+  constructor(data?: PartialMessage<ListAttachmentsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ListAttachmentsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "attachments", kind: "message", T: Attachment, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAttachmentsResponse {
+    return new ListAttachmentsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAttachmentsResponse {
+    return new ListAttachmentsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAttachmentsResponse {
+    return new ListAttachmentsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAttachmentsResponse | PlainMessage<ListAttachmentsResponse> | undefined, b: ListAttachmentsResponse | PlainMessage<ListAttachmentsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAttachmentsResponse, a, b);
+  }
+}
+
+/**
+ * UploadAttachment streams a file into an attachment of a container or item, the attachment only
+ * becomes visible once the stream completes successfully
+ *
+ * @generated from message v1.UploadAttachmentRequest
+ */
+export class UploadAttachmentRequest extends Message<UploadAttachmentRequest> {
+  /**
+   * path, name and overwrite are only read from the first message of the stream
+   *
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * name is the filename of the attachment, it cannot contain '/', '\' or NUL characters or start with '.'
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * overwrite replaces an existing attachment with the same name instead of failing
+   *
+   * @generated from field: bool overwrite = 3;
+   */
+  overwrite = false;
+
+  /**
+   * data is the next chunk of the file
+   *
+   * @generated from field: bytes data = 4;
+   */
+  data = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<UploadAttachmentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.UploadAttachmentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "overwrite", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadAttachmentRequest {
+    return new UploadAttachmentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadAttachmentRequest {
+    return new UploadAttachmentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadAttachmentRequest {
+    return new UploadAttachmentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadAttachmentRequest | PlainMessage<UploadAttachmentRequest> | undefined, b: UploadAttachmentRequest | PlainMessage<UploadAttachmentRequest> | undefined): boolean {
+    return proto3.util.equals(UploadAttachmentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.UploadAttachmentResponse
+ */
+export class UploadAttachmentResponse extends Message<UploadAttachmentResponse> {
+  /**
+   * @generated from field: v1.Attachment attachment = 1;
+   */
+  attachment?: Attachment;
+
+  constructor(data?: PartialMessage<UploadAttachmentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.UploadAttachmentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "attachment", kind: "message", T: Attachment },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadAttachmentResponse {
+    return new UploadAttachmentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadAttachmentResponse {
+    return new UploadAttachmentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadAttachmentResponse {
+    return new UploadAttachmentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadAttachmentResponse | PlainMessage<UploadAttachmentResponse> | undefined, b: UploadAttachmentResponse | PlainMessage<UploadAttachmentResponse> | undefined): boolean {
+    return proto3.util.equals(UploadAttachmentResponse, a, b);
+  }
+}
+
+/**
+ * DownloadAttachment streams the contents of an attachment of a container or item in chunks
+ *
+ * @generated from message v1.DownloadAttachmentRequest
+ */
+export class DownloadAttachmentRequest extends Message<DownloadAttachmentRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<DownloadAttachmentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.DownloadAttachmentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DownloadAttachmentRequest {
+    return new DownloadAttachmentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DownloadAttachmentRequest {
+    return new DownloadAttachmentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DownloadAttachmentRequest {
+    return new DownloadAttachmentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DownloadAttachmentRequest | PlainMessage<DownloadAttachmentRequest> | undefined, b: DownloadAttachmentRequest | PlainMessage<DownloadAttachmentRequest> | undefined): boolean {
+    return proto3.util.equals(DownloadAttachmentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.DownloadAttachmentResponse
+ */
+export class DownloadAttachmentResponse extends Message<DownloadAttachmentResponse> {
+  /**
+   * attachment is only set in the first message of the stream
+   *
+   * @generated from field: v1.Attachment attachment = 1;
+   */
+  attachment?: Attachment;
+
+  /**
+   * data is the next chunk of the file
+   *
+   * @generated from field: bytes data = 2;
+   */
+  data = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<DownloadAttachmentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.DownloadAttachmentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "attachment", kind: "message", T: Attachment },
+    { no: 2, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DownloadAttachmentResponse {
+    return new DownloadAttachmentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DownloadAttachmentResponse {
+    return new DownloadAttachmentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DownloadAttachmentResponse {
+    return new DownloadAttachmentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DownloadAttachmentResponse | PlainMessage<DownloadAttachmentResponse> | undefined, b: DownloadAttachmentResponse | PlainMessage<DownloadAttachmentResponse> | undefined): boolean {
+    return proto3.util.equals(DownloadAttachmentResponse, a, b);
+  }
+}
+
+/**
+ * QuantityChange records a single change made by AdjustQuantity
+ *
+ * @generated from message v1.QuantityChange
+ */
+export class QuantityChange extends Message<QuantityChange> {
+  /**
+   * @generated from field: google.protobuf.Timestamp time = 1;
+   */
+  time?: Timestamp;
+
+  /**
+   * @generated from field: double delta = 2;
+   */
+  delta = 0;
+
+  /**
+   * quantity is the quantity after the change
+   *
+   * @generated from field: double quantity = 3;
+   */
+  quantity = 0;
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<QuantityChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.QuantityChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time", kind: "message", T: Timestamp },
+    { no: 2, name: "delta", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "quantity", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuantityChange {
+    return new QuantityChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuantityChange {
+    return new QuantityChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuantityChange {
+    return new QuantityChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QuantityChange | PlainMessage<QuantityChange> | undefined, b: QuantityChange | PlainMessage<QuantityChange> | undefined): boolean {
+    return proto3.util.equals(QuantityChange, a, b);
+  }
+}
+
+/**
+ * AdjustQuantity adds delta to the quantity field of a container or item and records the change,
+ * entries without a quantity start at zero
+ *
+ * @generated from message v1.AdjustQuantityRequest
+ */
+export class AdjustQuantityRequest extends Message<AdjustQuantityRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * delta is negative when something is consumed and positive when it is restocked
+   *
+   * @generated from field: double delta = 2;
+   */
+  delta = 0;
+
+  /**
+   * reason is an optional note describing the change, like "replaced smoke detector batteries"
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<AdjustQuantityRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.AdjustQuantityRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "delta", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdjustQuantityRequest {
+    return new AdjustQuantityRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AdjustQuantityRequest {
+    return new AdjustQuantityRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AdjustQuantityRequest {
+    return new AdjustQuantityRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AdjustQuantityRequest | PlainMessage<AdjustQuantityRequest> | undefined, b: AdjustQuantityRequest | PlainMessage<AdjustQuantityRequest> | undefined): boolean {
+    return proto3.util.equals(AdjustQuantityRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.AdjustQuantityResponse
+ */
+export class AdjustQuantityResponse extends Message<AdjustQuantityResponse> {
+  /**
+   * quantity is the quantity after the change
+   *
+   * @generated from field: double quantity = 1;
+   */
+  quantity = 0;
+
+  constructor(data?: PartialMessage<AdjustQuantityResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.AdjustQuantityResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "quantity", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdjustQuantityResponse {
+    return new AdjustQuantityResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AdjustQuantityResponse {
+    return new AdjustQuantityResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AdjustQuantityResponse {
+    return new AdjustQuantityResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AdjustQuantityResponse | PlainMessage<AdjustQuantityResponse> | undefined, b: AdjustQuantityResponse | PlainMessage<AdjustQuantityResponse> | undefined): boolean {
+    return proto3.util.equals(AdjustQuantityResponse, a, b);
+  }
+}
+
+/**
+ * QuantityHistory lists the changes made by AdjustQuantity to a container or item, oldest first
+ *
+ * @generated from message v1.QuantityHistoryRequest
+ */
+export class QuantityHistoryRequest extends Message<QuantityHistoryRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  constructor(data?: PartialMessage<QuantityHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.QuantityHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuantityHistoryRequest {
+    return new QuantityHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuantityHistoryRequest {
+    return new QuantityHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuantityHistoryRequest {
+    return new QuantityHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QuantityHistoryRequest | PlainMessage<QuantityHistoryRequest> | undefined, b: QuantityHistoryRequest | PlainMessage<QuantityHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(QuantityHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.QuantityHistoryResponse
+ */
+export class QuantityHistoryResponse extends Message<QuantityHistoryResponse> {
+  /**
+   * @generated from field: repeated v1.QuantityChange changes = 1;
+   */
+  changes: QuantityChange[] = [];
+
+  constructor(data?: PartialMessage<QuantityHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.QuantityHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "changes", kind: "message", T: QuantityChange, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuantityHistoryResponse {
+    return new QuantityHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuantityHistoryResponse {
+    return new QuantityHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuantityHistoryResponse {
+    return new QuantityHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QuantityHistoryResponse | PlainMessage<QuantityHistoryResponse> | undefined, b: QuantityHistoryResponse | PlainMessage<QuantityHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(QuantityHistoryResponse, a, b);
+  }
+}
+
+/**
+ * LowStock lists every entry whose quantity field is below its min_quantity field
+ *
+ * @generated from message v1.LowStockRequest
+ */
+export class LowStockRequest extends Message<LowStockRequest> {
+  /**
+   * only entries inside this subtree are listed, this should follow the same convention as the path in
+   * ReadRequest, an empty path lists the whole archive
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  constructor(data?: PartialMessage<LowStockRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.LowStockRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LowStockRequest {
+    return new LowStockRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LowStockRequest {
+    return new LowStockRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LowStockRequest {
+    return new LowStockRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LowStockRequest | PlainMessage<LowStockRequest> | undefined, b: LowStockRequest | PlainMessage<LowStockRequest> | undefined): boolean {
+    return proto3.util.equals(LowStockRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.LowStockResponse
+ */
+export class LowStockResponse extends Message<LowStockResponse> {
+  /**
+   * @generated from field: repeated v1.LowStockResponse.Entry entries = 1;
+   */
+  entries: LowStockResponse_Entry[] = [];
+
+  constructor(data?: PartialMessage<LowStockResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.LowStockResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: LowStockResponse_Entry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LowStockResponse {
+    return new LowStockResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LowStockResponse {
+    return new LowStockResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LowStockResponse {
+    return new LowStockResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LowStockResponse | PlainMessage<LowStockResponse> | undefined, b: LowStockResponse | PlainMessage<LowStockResponse> | undefined): boolean {
+    return proto3.util.equals(LowStockResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.LowStockResponse.Entry
+ */
+export class LowStockResponse_Entry extends Message<LowStockResponse_Entry> {
+  /**
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * @generated from field: v1.EntryMetadata meta = 2;
+   */
+  meta?: EntryMetadata;
+
+  constructor(data?: PartialMessage<LowStockResponse_Entry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.LowStockResponse.Entry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "meta", kind: "message", T: EntryMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LowStockResponse_Entry {
+    return new LowStockResponse_Entry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LowStockResponse_Entry {
+    return new LowStockResponse_Entry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LowStockResponse_Entry {
+    return new LowStockResponse_Entry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LowStockResponse_Entry | PlainMessage<LowStockResponse_Entry> | undefined, b: LowStockResponse_Entry | PlainMessage<LowStockResponse_Entry> | undefined): boolean {
+    return proto3.util.equals(LowStockResponse_Entry, a, b);
+  }
+}
+
+/**
+ * Upcoming lists the dates of entries that fall within the next days, earliest first
+ *
+ * @generated from message v1.UpcomingRequest
+ */
+export class UpcomingRequest extends Message<UpcomingRequest> {
+  /**
+   * only entries inside this subtree are listed, this should follow the same convention as the path in
+   * ReadRequest, an empty path lists the whole archive
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * days is the number of days after today that are included, 30 days are used if it is zero
+   *
+   * @generated from field: uint32 days = 2;
+   */
+  days = 0;
+
+  /**
+   * include_past includes dates before today, like food that has already expired
+   *
+   * @generated from field: bool include_past = 3;
+   */
+  includePast = false;
+
+  /**
+   * fields are the date fields that are looked at, the deadline fields expires, warranty_until and
+   * next_maintenance are used if it is empty
+   *
+   * @generated from field: repeated string fields = 4;
+   */
+  fields: string[] = [];
+
+  constructor(data?: PartialMessage<UpcomingRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.UpcomingRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "days", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "include_past", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "fields", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpcomingRequest {
+    return new UpcomingRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpcomingRequest {
+    return new UpcomingRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpcomingRequest {
+    return new UpcomingRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpcomingRequest | PlainMessage<UpcomingRequest> | undefined, b: UpcomingRequest | PlainMessage<UpcomingRequest> | undefined): boolean {
+    return proto3.util.equals(UpcomingRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.UpcomingResponse
+ */
+export class UpcomingResponse extends Message<UpcomingResponse> {
+  /**
+   * an entry is listed once for every field whose date is included
+   *
+   * @generated from field: repeated v1.UpcomingResponse.Entry entries = 1;
+   */
+  entries: UpcomingResponse_Entry[] = [];
+
+  constructor(data?: PartialMessage<UpcomingResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.UpcomingResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: UpcomingResponse_Entry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpcomingResponse {
+    return new UpcomingResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpcomingResponse {
+    return new UpcomingResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpcomingResponse {
+    return new UpcomingResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpcomingResponse | PlainMessage<UpcomingResponse> | undefined, b: UpcomingResponse | PlainMessage<UpcomingResponse> | undefined): boolean {
+    return proto3.util.equals(UpcomingResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.UpcomingResponse.Entry
+ */
+export class UpcomingResponse_Entry extends Message<UpcomingResponse_Entry> {
+  /**
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * @generated from field: v1.EntryMetadata meta = 2;
+   */
+  meta?: EntryMetadata;
+
+  /**
+   * field is the key of the date field
+   *
+   * @generated from field: string field = 3;
+   */
+  field = "";
+
+  /**
+   * date is formatted as YYYY-MM-DD
+   *
+   * @generated from field: string date = 4;
+   */
+  date = "";
+
+  /**
+   * days_left is the number of days from today until date, it is negative for dates that have passed
+   *
+   * @generated from field: int32 days_left = 5;
+   */
+  daysLeft = 0;
+
+  constructor(data?: PartialMessage<UpcomingResponse_Entry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.UpcomingResponse.Entry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "meta", kind: "message", T: EntryMetadata },
+    { no: 3, name: "field", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "days_left", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpcomingResponse_Entry {
+    return new UpcomingResponse_Entry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpcomingResponse_Entry {
+    return new UpcomingResponse_Entry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpcomingResponse_Entry {
+    return new UpcomingResponse_Entry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpcomingResponse_Entry | PlainMessage<UpcomingResponse_Entry> | undefined, b: UpcomingResponse_Entry | PlainMessage<UpcomingResponse_Entry> | undefined): boolean {
+    return proto3.util.equals(UpcomingResponse_Entry, a, b);
+  }
+}
+
+/**
+ * MaintenanceRecord records a single maintenance logged by LogMaintenance
+ *
+ * @generated from message v1.MaintenanceRecord
+ */
+export class MaintenanceRecord extends Message<MaintenanceRecord> {
+  /**
+   * time is when the maintenance was logged
+   *
+   * @generated from field: google.protobuf.Timestamp time = 1;
+   */
+  time?: Timestamp;
+
+  /**
+   * date is the date the maintenance was done formatted as YYYY-MM-DD
+   *
+   * @generated from field: string date = 2;
+   */
+  date = "";
+
+  /**
+   * @generated from field: string note = 3;
+   */
+  note = "";
+
+  /**
+   * due is the next_maintenance date the maintenance was done for, it is empty if there was none
+   *
+   * @generated from field: string due = 4;
+   */
+  due = "";
+
+  constructor(data?: PartialMessage<MaintenanceRecord>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.MaintenanceRecord";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time", kind: "message", T: Timestamp },
+    { no: 2, name: "date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "due", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MaintenanceRecord {
+    return new MaintenanceRecord().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MaintenanceRecord {
+    return new MaintenanceRecord().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MaintenanceRecord {
+    return new MaintenanceRecord().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MaintenanceRecord | PlainMessage<MaintenanceRecord> | undefined, b: MaintenanceRecord | PlainMessage<MaintenanceRecord> | undefined): boolean {
+    return proto3.util.equals(MaintenanceRecord, a, b);
+  }
+}
+
+/**
+ * LogMaintenance records that maintenance was done on an entry and sets its next_maintenance field
+ * from its maintenance_rule, entries without a rule have their next_maintenance removed as it is done
+ *
+ * @generated from message v1.LogMaintenanceRequest
+ */
+export class LogMaintenanceRequest extends Message<LogMaintenanceRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * note is an optional note describing the maintenance, like "replaced the filter"
+   *
+   * @generated from field: string note = 2;
+   */
+  note = "";
+
+  /**
+   * date is the date the maintenance was done formatted as YYYY-MM-DD, today is used if it is empty,
+   * the next maintenance is due one interval of the rule after it
+   *
+   * @generated from field: string date = 3;
+   */
+  date = "";
+
+  constructor(data?: PartialMessage<LogMaintenanceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.LogMaintenanceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LogMaintenanceRequest {
+    return new LogMaintenanceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LogMaintenanceRequest {
+    return new LogMaintenanceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LogMaintenanceRequest {
+    return new LogMaintenanceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LogMaintenanceRequest | PlainMessage<LogMaintenanceRequest> | undefined, b: LogMaintenanceRequest | PlainMessage<LogMaintenanceRequest> | undefined): boolean {
+    return proto3.util.equals(LogMaintenanceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.LogMaintenanceResponse
+ */
+export class LogMaintenanceResponse extends Message<LogMaintenanceResponse> {
+  /**
+   * next_maintenance is the date the next maintenance is due, it is empty if the entry has no rule
+   *
+   * @generated from field: string next_maintenance = 1;
+   */
+  nextMaintenance = "";
+
+  constructor(data?: PartialMessage<LogMaintenanceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.LogMaintenanceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "next_maintenance", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LogMaintenanceResponse {
+    return new LogMaintenanceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LogMaintenanceResponse {
+    return new LogMaintenanceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LogMaintenanceResponse {
+    return new LogMaintenanceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LogMaintenanceResponse | PlainMessage<LogMaintenanceResponse> | undefined, b: LogMaintenanceResponse | PlainMessage<LogMaintenanceResponse> | undefined): boolean {
+    return proto3.util.equals(LogMaintenanceResponse, a, b);
+  }
+}
+
+/**
+ * MaintenanceHistory lists the maintenance logged for an entry, oldest first
+ *
+ * @generated from message v1.MaintenanceHistoryRequest
+ */
+export class MaintenanceHistoryRequest extends Message<MaintenanceHistoryRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  constructor(data?: PartialMessage<MaintenanceHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.MaintenanceHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MaintenanceHistoryRequest {
+    return new MaintenanceHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MaintenanceHistoryRequest {
+    return new MaintenanceHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MaintenanceHistoryRequest {
+    return new MaintenanceHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MaintenanceHistoryRequest | PlainMessage<MaintenanceHistoryRequest> | undefined, b: MaintenanceHistoryRequest | PlainMessage<MaintenanceHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(MaintenanceHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.MaintenanceHistoryResponse
+ */
+export class MaintenanceHistoryResponse extends Message<MaintenanceHistoryResponse> {
+  /**
+   * @generated from field: repeated v1.MaintenanceRecord records = 1;
+   */
+  records: MaintenanceRecord[] = [];
+
+  constructor(data?: PartialMessage<MaintenanceHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.MaintenanceHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "records", kind: "message", T: MaintenanceRecord, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MaintenanceHistoryResponse {
+    return new MaintenanceHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MaintenanceHistoryResponse {
+    return new MaintenanceHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MaintenanceHistoryResponse {
+    return new MaintenanceHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MaintenanceHistoryResponse | PlainMessage<MaintenanceHistoryResponse> | undefined, b: MaintenanceHistoryResponse | PlainMessage<MaintenanceHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(MaintenanceHistoryResponse, a, b);
+  }
+}
+
+/**
+ * DueMaintenance lists the entries whose next_maintenance is due within the next days, including
+ * maintenance that is overdue, earliest first
+ *
+ * @generated from message v1.DueMaintenanceRequest
+ */
+export class DueMaintenanceRequest extends Message<DueMaintenanceRequest> {
+  /**
+   * only entries inside this subtree are listed, this should follow the same convention as the path in
+   * ReadRequest, an empty path lists the whole archive
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * days is the number of days after today that are included, 30 days are used if it is zero
+   *
+   * @generated from field: uint32 days = 2;
+   */
+  days = 0;
+
+  constructor(data?: PartialMessage<DueMaintenanceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.DueMaintenanceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "days", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DueMaintenanceRequest {
+    return new DueMaintenanceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DueMaintenanceRequest {
+    return new DueMaintenanceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DueMaintenanceRequest {
+    return new DueMaintenanceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DueMaintenanceRequest | PlainMessage<DueMaintenanceRequest> | undefined, b: DueMaintenanceRequest | PlainMessage<DueMaintenanceRequest> | undefined): boolean {
+    return proto3.util.equals(DueMaintenanceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.DueMaintenanceResponse
+ */
+export class DueMaintenanceResponse extends Message<DueMaintenanceResponse> {
+  /**
+   * @generated from field: repeated v1.UpcomingResponse.Entry entries = 1;
+   */
+  entries: UpcomingResponse_Entry[] = [];
+
+  constructor(data?: PartialMessage<DueMaintenanceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.DueMaintenanceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: UpcomingResponse_Entry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DueMaintenanceResponse {
+    return new DueMaintenanceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DueMaintenanceResponse {
+    return new DueMaintenanceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DueMaintenanceResponse {
+    return new DueMaintenanceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DueMaintenanceResponse | PlainMessage<DueMaintenanceResponse> | undefined, b: DueMaintenanceResponse | PlainMessage<DueMaintenanceResponse> | undefined): boolean {
+    return proto3.util.equals(DueMaintenanceResponse, a, b);
+  }
+}
+
+/**
+ * CheckOut records that an item is lent to someone, the item stays where it is in the archive
+ *
+ * @generated from message v1.CheckOutRequest
+ */
+export class CheckOutRequest extends Message<CheckOutRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * @generated from field: string borrower = 2;
+   */
+  borrower = "";
+
+  /**
+   * due is the date the item should be returned by formatted as YYYY-MM-DD, it is optional
+   *
+   * @generated from field: string due = 3;
+   */
+  due = "";
+
+  /**
+   * @generated from field: string note = 4;
+   */
+  note = "";
+
+  constructor(data?: PartialMessage<CheckOutRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.CheckOutRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "borrower", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "due", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckOutRequest {
+    return new CheckOutRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CheckOutRequest {
+    return new CheckOutRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CheckOutRequest {
+    return new CheckOutRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CheckOutRequest | PlainMessage<CheckOutRequest> | undefined, b: CheckOutRequest | PlainMessage<CheckOutRequest> | undefined): boolean {
+    return proto3.util.equals(CheckOutRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.CheckOutResponse
+ */
+export class CheckOutResponse extends Message<CheckOutResponse> {
+  /**
+   * @generated from field: v1.Loan loan = 1;
+   */
+  loan?: Loan;
+
+  constructor(data?: PartialMessage<CheckOutResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.CheckOutResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "loan", kind: "message", T: Loan },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckOutResponse {
+    return new CheckOutResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CheckOutResponse {
+    return new CheckOutResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CheckOutResponse {
+    return new CheckOutResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CheckOutResponse | PlainMessage<CheckOutResponse> | undefined, b: CheckOutResponse | PlainMessage<CheckOutResponse> | undefined): boolean {
+    return proto3.util.equals(CheckOutResponse, a, b);
+  }
+}
+
+/**
+ * CheckIn records that a checked out item was returned
+ *
+ * @generated from message v1.CheckInRequest
+ */
+export class CheckInRequest extends Message<CheckInRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  constructor(data?: PartialMessage<CheckInRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.CheckInRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckInRequest {
+    return new CheckInRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CheckInRequest {
+    return new CheckInRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CheckInRequest {
+    return new CheckInRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CheckInRequest | PlainMessage<CheckInRequest> | undefined, b: CheckInRequest | PlainMessage<CheckInRequest> | undefined): boolean {
+    return proto3.util.equals(CheckInRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.CheckInResponse
+ */
+export class CheckInResponse extends Message<CheckInResponse> {
+  /**
+   * loan is the loan that ended
+   *
+   * @generated from field: v1.Loan loan = 1;
+   */
+  loan?: Loan;
+
+  constructor(data?: PartialMessage<CheckInResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.CheckInResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "loan", kind: "message", T: Loan },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckInResponse {
+    return new CheckInResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CheckInResponse {
+    return new CheckInResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CheckInResponse {
+    return new CheckInResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CheckInResponse | PlainMessage<CheckInResponse> | undefined, b: CheckInResponse | PlainMessage<CheckInResponse> | undefined): boolean {
+    return proto3.util.equals(CheckInResponse, a, b);
+  }
+}
+
+/**
+ * ListCheckedOut lists every item that is checked out, the search query `status:out` finds them as well
+ *
+ * @generated from message v1.ListCheckedOutRequest
+ */
+export class ListCheckedOutRequest extends Message<ListCheckedOutRequest> {
+  /**
+   * only items inside this subtree are listed, this should follow the same convention as the path in
+   * ReadRequest, an empty path lists the whole archive
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * overdue_only leaves out the items that are not overdue
+   *
+   * @generated from field: bool overdue_only = 2;
+   */
+  overdueOnly = false;
+
+  constructor(data?: PartialMessage<ListCheckedOutRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ListCheckedOutRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "overdue_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCheckedOutRequest {
+    return new ListCheckedOutRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCheckedOutRequest {
+    return new ListCheckedOutRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCheckedOutRequest {
+    return new ListCheckedOutRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCheckedOutRequest | PlainMessage<ListCheckedOutRequest> | undefined, b: ListCheckedOutRequest | PlainMessage<ListCheckedOutRequest> | undefined): boolean {
+    return proto3.util.equals(ListCheckedOutRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ListCheckedOutResponse
+ */
+export class ListCheckedOutResponse extends Message<ListCheckedOutResponse> {
+  /**
+   * entries are sorted by their due date, entries without a due date come last
+   *
+   * @generated from field: repeated v1.ListCheckedOutResponse.Entry entries = 1;
+   */
+  entries: ListCheckedOutResponse_Entry[] = [];
+
+  constructor(data?: PartialMessage<ListCheckedOutResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ListCheckedOutResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: ListCheckedOutResponse_Entry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCheckedOutResponse {
+    return new ListCheckedOutResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCheckedOutResponse {
+    return new ListCheckedOutResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCheckedOutResponse {
+    return new ListCheckedOutResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCheckedOutResponse | PlainMessage<ListCheckedOutResponse> | undefined, b: ListCheckedOutResponse | PlainMessage<ListCheckedOutResponse> | undefined): boolean {
+    return proto3.util.equals(ListCheckedOutResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ListCheckedOutResponse.Entry
+ */
+export class ListCheckedOutResponse_Entry extends Message<ListCheckedOutResponse_Entry> {
+  /**
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * @generated from field: v1.EntryMetadata meta = 2;
+   */
+  meta?: EntryMetadata;
+
+  /**
+   * overdue is true if the due date of the loan has passed
+   *
+   * @generated from field: bool overdue = 3;
+   */
+  overdue = false;
+
+  constructor(data?: PartialMessage<ListCheckedOutResponse_Entry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ListCheckedOutResponse.Entry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "meta", kind: "message", T: EntryMetadata },
+    { no: 3, name: "overdue", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCheckedOutResponse_Entry {
+    return new ListCheckedOutResponse_Entry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCheckedOutResponse_Entry {
+    return new ListCheckedOutResponse_Entry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCheckedOutResponse_Entry {
+    return new ListCheckedOutResponse_Entry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCheckedOutResponse_Entry | PlainMessage<ListCheckedOutResponse_Entry> | undefined, b: ListCheckedOutResponse_Entry | PlainMessage<ListCheckedOutResponse_Entry> | undefined): boolean {
+    return proto3.util.equals(ListCheckedOutResponse_Entry, a, b);
+  }
+}
+
+/**
+ * Move can move a container or an item
+ *
+ * @generated from message v1.MoveRequest
+ */
+export class MoveRequest extends Message<MoveRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string src = 1;
+   */
+  src: string[] = [];
+
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string dest = 2;
+   */
+  dest: string[] = [];
+
+  constructor(data?: PartialMessage<MoveRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.MoveRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "src", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "dest", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MoveRequest {
+    return new MoveRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MoveRequest {
+    return new MoveRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MoveRequest {
+    return new MoveRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MoveRequest | PlainMessage<MoveRequest> | undefined, b: MoveRequest | PlainMessage<MoveRequest> | undefined): boolean {
+    return proto3.util.equals(MoveRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.MoveResponse
+ */
+export class MoveResponse extends Message<MoveResponse> {
+  constructor(data?: PartialMessage<MoveResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.MoveResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MoveResponse {
+    return new MoveResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MoveResponse {
+    return new MoveResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MoveResponse {
+    return new MoveResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MoveResponse | PlainMessage<MoveResponse> | undefined, b: MoveResponse | PlainMessage<MoveResponse> | undefined): boolean {
+    return proto3.util.equals(MoveResponse, a, b);
+  }
+}
+
+/**
+ * Delete moves a container or an item to the trash
+ *
+ * @generated from message v1.DeleteRequest
+ */
+export class DeleteRequest extends Message<DeleteRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  constructor(data?: PartialMessage<DeleteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.DeleteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRequest {
+    return new DeleteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRequest {
+    return new DeleteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRequest {
+    return new DeleteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRequest | PlainMessage<DeleteRequest> | undefined, b: DeleteRequest | PlainMessage<DeleteRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.DeleteResponse
+ */
+export class DeleteResponse extends Message<DeleteResponse> {
+  /**
+   * trash_id identifies the deleted entry in the trash, it can be passed to Restore
+   *
+   * @generated from field: string trash_id = 1;
+   */
+  trashId = "";
+
+  constructor(data?: PartialMessage<DeleteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.DeleteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "trash_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteResponse {
+    return new DeleteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteResponse {
+    return new DeleteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteResponse {
+    return new DeleteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteResponse | PlainMessage<DeleteResponse> | undefined, b: DeleteResponse | PlainMessage<DeleteResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteResponse, a, b);
+  }
+}
+
+/**
+ * TrashEntry describes an entry that was deleted but can still be restored
+ *
+ * @generated from message v1.TrashEntry
+ */
+export class TrashEntry extends Message<TrashEntry> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * path is where the entry was deleted from, it follows the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 2;
+   */
+  path: string[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp deleted_at = 3;
+   */
+  deletedAt?: Timestamp;
+
+  /**
+   * @generated from field: bool is_container = 4;
+   */
+  isContainer = false;
+
+  /**
+   * @generated from field: v1.EntryMetadata metadata = 5;
+   */
+  metadata?: EntryMetadata;
+
+  constructor(data?: PartialMessage<TrashEntry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.TrashEntry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "deleted_at", kind: "message", T: Timestamp },
+    { no: 4, name: "is_container", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "metadata", kind: "message", T: EntryMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TrashEntry {
+    return new TrashEntry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TrashEntry {
+    return new TrashEntry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TrashEntry {
+    return new TrashEntry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TrashEntry | PlainMessage<TrashEntry> | undefined, b: TrashEntry | PlainMessage<TrashEntry> | undefined): boolean {
+    return proto3.util.equals(TrashEntry, a, b);
+  }
+}
+
+/**
+ * ListTrash lists the entries in the trash, most recently deleted first
+ *
+ * @generated from message v1.ListTrashRequest
+ */
+export class ListTrashRequest extends Message<ListTrashRequest> {
+  constructor(data?: PartialMessage<ListTrashRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ListTrashRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTrashRequest {
+    return new ListTrashRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTrashRequest {
+    return new ListTrashRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTrashRequest {
+    return new ListTrashRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTrashRequest | PlainMessage<ListTrashRequest> | undefined, b: ListTrashRequest | PlainMessage<ListTrashRequest> | undefined): boolean {
+    return proto3.util.equals(ListTrashRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ListTrashResponse
+ */
+export class ListTrashResponse extends Message<ListTrashResponse> {
+  /**
+   * @generated from field: repeated v1.TrashEntry entries = 1;
+   */
+  entries: TrashEntry[] = [];
+
+  constructor(data?: PartialMessage<ListTrashResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ListTrashResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: TrashEntry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTrashResponse {
+    return new ListTrashResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTrashResponse {
+    return new ListTrashResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTrashResponse {
+    return new ListTrashResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTrashResponse | PlainMessage<ListTrashResponse> | undefined, b: ListTrashResponse | PlainMessage<ListTrashResponse> | undefined): boolean {
+    return proto3.util.equals(ListTrashResponse, a, b);
+  }
+}
+
+/**
+ * Restore moves an entry out of the trash
+ *
+ * @generated from message v1.RestoreRequest
+ */
+export class RestoreRequest extends Message<RestoreRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * dest is where the entry should be restored to, it defaults to the path the entry was deleted from
+   *
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string dest = 2;
+   */
+  dest: string[] = [];
+
+  constructor(data?: PartialMessage<RestoreRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RestoreRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "dest", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreRequest {
+    return new RestoreRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreRequest {
+    return new RestoreRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreRequest {
+    return new RestoreRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestoreRequest | PlainMessage<RestoreRequest> | undefined, b: RestoreRequest | PlainMessage<RestoreRequest> | undefined): boolean {
+    return proto3.util.equals(RestoreRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RestoreResponse
+ */
+export class RestoreResponse extends Message<RestoreResponse> {
+  /**
+   * path is where the entry was restored to
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  constructor(data?: PartialMessage<RestoreResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RestoreResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreResponse {
+    return new RestoreResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreResponse {
+    return new RestoreResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreResponse {
+    return new RestoreResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestoreResponse | PlainMessage<RestoreResponse> | undefined, b: RestoreResponse | PlainMessage<RestoreResponse> | undefined): boolean {
+    return proto3.util.equals(RestoreResponse, a, b);
+  }
+}
+
+/**
+ * EmptyTrash permanently deletes entries in the trash
+ *
+ * @generated from message v1.EmptyTrashRequest
+ */
+export class EmptyTrashRequest extends Message<EmptyTrashRequest> {
+  /**
+   * ids limits which entries are deleted, every entry in the trash is deleted if it is empty
+   *
+   * @generated from field: repeated string ids = 1;
+   */
+  ids: string[] = [];
+
+  constructor(data?: PartialMessage<EmptyTrashRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.EmptyTrashRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EmptyTrashRequest {
+    return new EmptyTrashRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EmptyTrashRequest {
+    return new EmptyTrashRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EmptyTrashRequest {
+    return new EmptyTrashRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EmptyTrashRequest | PlainMessage<EmptyTrashRequest> | undefined, b: EmptyTrashRequest | PlainMessage<EmptyTrashRequest> | undefined): boolean {
+    return proto3.util.equals(EmptyTrashRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.EmptyTrashResponse
+ */
+export class EmptyTrashResponse extends Message<EmptyTrashResponse> {
+  constructor(data?: PartialMessage<EmptyTrashResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.EmptyTrashResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EmptyTrashResponse {
+    return new EmptyTrashResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EmptyTrashResponse {
+    return new EmptyTrashResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EmptyTrashResponse {
+    return new EmptyTrashResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EmptyTrashResponse | PlainMessage<EmptyTrashResponse> | undefined, b: EmptyTrashResponse | PlainMessage<EmptyTrashResponse> | undefined): boolean {
+    return proto3.util.equals(EmptyTrashResponse, a, b);
+  }
+}
+
+/**
+ * Search
+ *
+ * @generated from message v1.SearchRequest
+ */
+export class SearchRequest extends Message<SearchRequest> {
+  /**
+   * query supports terms like `tag:fruit`, `id:abc`, `desc:word`, `is:item`, `in:garage.container`,
+   * `status:out`, `brand:bosch` or `price>50` combined with AND, OR, NOT and parentheses, the full syntax is
+   * described in internal/service/query.go
+   *
+   * @generated from field: string query = 1;
+   */
+  query = "";
+
+  constructor(data?: PartialMessage<SearchRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.SearchRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchRequest {
+    return new SearchRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchRequest {
+    return new SearchRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchRequest {
+    return new SearchRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchRequest | PlainMessage<SearchRequest> | undefined, b: SearchRequest | PlainMessage<SearchRequest> | undefined): boolean {
+    return proto3.util.equals(SearchRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.SearchResponse
+ */
+export class SearchResponse extends Message<SearchResponse> {
+  /**
+   * @generated from field: repeated v1.SearchResponse.Entry entries = 1;
+   */
+  entries: SearchResponse_Entry[] = [];
+
+  constructor(data?: PartialMessage<SearchResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.SearchResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: SearchResponse_Entry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchResponse {
+    return new SearchResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchResponse {
+    return new SearchResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchResponse {
+    return new SearchResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchResponse | PlainMessage<SearchResponse> | undefined, b: SearchResponse | PlainMessage<SearchResponse> | undefined): boolean {
+    return proto3.util.equals(SearchResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.SearchResponse.Entry
+ */
+export class SearchResponse_Entry extends Message<SearchResponse_Entry> {
+  /**
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * @generated from field: v1.EntryMetadata meta = 2;
+   */
+  meta?: EntryMetadata;
+
+  constructor(data?: PartialMessage<SearchResponse_Entry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.SearchResponse.Entry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "meta", kind: "message", T: EntryMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchResponse_Entry {
+    return new SearchResponse_Entry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchResponse_Entry {
+    return new SearchResponse_Entry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchResponse_Entry {
+    return new SearchResponse_Entry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchResponse_Entry | PlainMessage<SearchResponse_Entry> | undefined, b: SearchResponse_Entry | PlainMessage<SearchResponse_Entry> | undefined): boolean {
+    return proto3.util.equals(SearchResponse_Entry, a, b);
+  }
+}
+
+/**
+ * JournalEntry records a single change made through the service
+ *
+ * @generated from message v1.JournalEntry
+ */
+export class JournalEntry extends Message<JournalEntry> {
+  /**
+   * @generated from field: google.protobuf.Timestamp time = 1;
+   */
+  time?: Timestamp;
+
+  /**
+   * client identifies who made the change, it is taken from the X-Archive-Client request header or
+   * the address of the client if the header is not set
+   *
+   * @generated from field: string client = 2;
+   */
+  client = "";
+
+  /**
+   * operation is the name of the rpc that made the change, like "Create" or "Move"
+   *
+   * @generated from field: string operation = 3;
+   */
+  operation = "";
+
+  /**
+   * before is the path of the entry before the change, it is empty for entries that were created, for
+   * entries restored from the trash it is the path they were deleted from
+   *
+   * @generated from field: repeated string before = 4;
+   */
+  before: string[] = [];
+
+  /**
+   * after is the path of the entry after the change, it is empty for entries that were deleted
+   *
+   * @generated from field: repeated string after = 5;
+   */
+  after: string[] = [];
+
+  /**
+   * detail describes the change further, like the fields that were updated or the name of an attachment
+   *
+   * @generated from field: string detail = 6;
+   */
+  detail = "";
+
+  /**
+   * action is the operation that was undone or redone, it is only set when operation is "Undo" or "Redo"
+   *
+   * @generated from field: string action = 7;
+   */
+  action = "";
+
+  constructor(data?: PartialMessage<JournalEntry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.JournalEntry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time", kind: "message", T: Timestamp },
+    { no: 2, name: "client", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "operation", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "before", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "after", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "detail", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JournalEntry {
+    return new JournalEntry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JournalEntry {
+    return new JournalEntry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JournalEntry {
+    return new JournalEntry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JournalEntry | PlainMessage<JournalEntry> | undefined, b: JournalEntry | PlainMessage<JournalEntry> | undefined): boolean {
+    return proto3.util.equals(JournalEntry, a, b);
+  }
+}
+
+/**
+ * History lists the changes made to an entry and everything inside it, most recent first
+ *
+ * changes made before the entry was moved or renamed are included as well
+ *
+ * @generated from message v1.HistoryRequest
+ */
+export class HistoryRequest extends Message<HistoryRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest, the entry does not need to exist
+   * anymore, an empty path lists the history of the whole archive
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * limit is the maximum number of entries returned, all entries are returned if it is zero
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<HistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.HistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HistoryRequest {
+    return new HistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HistoryRequest {
+    return new HistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HistoryRequest {
+    return new HistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HistoryRequest | PlainMessage<HistoryRequest> | undefined, b: HistoryRequest | PlainMessage<HistoryRequest> | undefined): boolean {
+    return proto3.util.equals(HistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.HistoryResponse
+ */
+export class HistoryResponse extends Message<HistoryResponse> {
+  /**
+   * @generated from field: repeated v1.JournalEntry entries = 1;
+   */
+  entries: JournalEntry[] = [];
+
+  constructor(data?: PartialMessage<HistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.HistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: JournalEntry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HistoryResponse {
+    return new HistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HistoryResponse {
+    return new HistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HistoryResponse {
+    return new HistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HistoryResponse | PlainMessage<HistoryResponse> | undefined, b: HistoryResponse | PlainMessage<HistoryResponse> | undefined): boolean {
+    return proto3.util.equals(HistoryResponse, a, b);
+  }
+}
+
+/**
+ * Undo reverts the most recent changes made by Create, Update, Move and Delete, one at a time starting
+ * with the most recent one
+ *
+ * the archive has a single undo history that is shared by all clients, a change is refused with a
+ * DIVERGED error when the entry was changed since in a way that conflicts with reverting it, like a
+ * created container that other programs put entries into, updates that replaced the image of an
+ * entry cannot be undone and are skipped
+ *
+ * @generated from message v1.UndoRequest
+ */
+export class UndoRequest extends Message<UndoRequest> {
+  /**
+   * count is the number of changes to undo, a single change is undone if it is zero
+   *
+   * @generated from field: uint32 count = 1;
+   */
+  count = 0;
+
+  constructor(data?: PartialMessage<UndoRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.UndoRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UndoRequest {
+    return new UndoRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UndoRequest {
+    return new UndoRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UndoRequest {
+    return new UndoRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UndoRequest | PlainMessage<UndoRequest> | undefined, b: UndoRequest | PlainMessage<UndoRequest> | undefined): boolean {
+    return proto3.util.equals(UndoRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.UndoResponse
+ */
+export class UndoResponse extends Message<UndoResponse> {
+  /**
+   * entries are the journal entries recorded for the changes that were undone, most recent first
+   *
+   * @generated from field: repeated v1.JournalEntry entries = 1;
+   */
+  entries: JournalEntry[] = [];
+
+  constructor(data?: PartialMessage<UndoResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.UndoResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: JournalEntry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UndoResponse {
+    return new UndoResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UndoResponse {
+    return new UndoResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UndoResponse {
+    return new UndoResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UndoResponse | PlainMessage<UndoResponse> | undefined, b: UndoResponse | PlainMessage<UndoResponse> | undefined): boolean {
+    return proto3.util.equals(UndoResponse, a, b);
+  }
+}
+
+/**
+ * Redo makes the most recently undone changes again, this is possible until another change is made
+ *
+ * @generated from message v1.RedoRequest
+ */
+export class RedoRequest extends Message<RedoRequest> {
+  /**
+   * count is the number of changes to redo, a single change is redone if it is zero
+   *
+   * @generated from field: uint32 count = 1;
+   */
+  count = 0;
+
+  constructor(data?: PartialMessage<RedoRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RedoRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RedoRequest {
+    return new RedoRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RedoRequest {
+    return new RedoRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RedoRequest {
+    return new RedoRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RedoRequest | PlainMessage<RedoRequest> | undefined, b: RedoRequest | PlainMessage<RedoRequest> | undefined): boolean {
+    return proto3.util.equals(RedoRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RedoResponse
+ */
+export class RedoResponse extends Message<RedoResponse> {
+  /**
+   * entries are the journal entries recorded for the changes that were redone, in the order they were redone
+   *
+   * @generated from field: repeated v1.JournalEntry entries = 1;
+   */
+  entries: JournalEntry[] = [];
+
+  constructor(data?: PartialMessage<RedoResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RedoResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: JournalEntry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RedoResponse {
+    return new RedoResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RedoResponse {
+    return new RedoResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RedoResponse {
+    return new RedoResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RedoResponse | PlainMessage<RedoResponse> | undefined, b: RedoResponse | PlainMessage<RedoResponse> | undefined): boolean {
+    return proto3.util.equals(RedoResponse, a, b);
+  }
+}
+
+/**
+ * Revision is a commit in the git repository of the archive, see ListRevisions
+ *
+ * @generated from message v1.Revision
+ */
+export class Revision extends Message<Revision> {
+  /**
+   * id is the hex encoded hash of the commit
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp time = 2;
+   */
+  time?: Timestamp;
+
+  /**
+   * client identifies who made the change, the same way as in JournalEntry
+   *
+   * @generated from field: string client = 3;
+   */
+  client = "";
+
+  /**
+   * message describes the change, its first line names the operation and the paths of the entries
+   * it changed
+   *
+   * @generated from field: string message = 4;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<Revision>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Revision";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "time", kind: "message", T: Timestamp },
+    { no: 3, name: "client", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Revision {
+    return new Revision().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Revision {
+    return new Revision().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Revision {
+    return new Revision().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Revision | PlainMessage<Revision> | undefined, b: Revision | PlainMessage<Revision> | undefined): boolean {
+    return proto3.util.equals(Revision, a, b);
+  }
+}
+
+/**
+ * ListRevisions lists the revisions that changed an entry or anything inside it, most recent first
+ *
+ * revisions are only recorded when the server runs with versioning enabled, every change made through
+ * the service is then committed to a git repository at the root container, changes made to the archive
+ * directory by other programs are included in the next commit
+ *
+ * @generated from message v1.ListRevisionsRequest
+ */
+export class ListRevisionsRequest extends Message<ListRevisionsRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest, the entry does not need to exist
+   * anymore, an empty path lists every revision
+   *
+   * revisions from before the entry got its current path are not included
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * limit is the maximum number of revisions returned, all revisions are returned if it is zero
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListRevisionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ListRevisionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRevisionsRequest {
+    return new ListRevisionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRevisionsRequest {
+    return new ListRevisionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRevisionsRequest {
+    return new ListRevisionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRevisionsRequest | PlainMessage<ListRevisionsRequest> | undefined, b: ListRevisionsRequest | PlainMessage<ListRevisionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListRevisionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ListRevisionsResponse
+ */
+export class ListRevisionsResponse extends Message<ListRevisionsResponse> {
+  /**
+   * @generated from field: repeated v1.Revision revisions = 1;
+   */
+  revisions: Revision[] = [];
+
+  constructor(data?: PartialMessage<ListRevisionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ListRevisionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revisions", kind: "message", T: Revision, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRevisionsResponse {
+    return new ListRevisionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRevisionsResponse {
+    return new ListRevisionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRevisionsResponse {
+    return new ListRevisionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRevisionsResponse | PlainMessage<ListRevisionsResponse> | undefined, b: ListRevisionsResponse | PlainMessage<ListRevisionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListRevisionsResponse, a, b);
+  }
+}
+
+/**
+ * ReadAt reads an entry as it was at a revision
+ *
+ * @generated from message v1.ReadAtRequest
+ */
+export class ReadAtRequest extends Message<ReadAtRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  /**
+   * revision is the id of a revision, a prefix of it or anything else git understands as a revision,
+   * like "HEAD~2"
+   *
+   * @generated from field: string revision = 2;
+   */
+  revision = "";
+
+  constructor(data?: PartialMessage<ReadAtRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ReadAtRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "revision", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReadAtRequest {
+    return new ReadAtRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReadAtRequest {
+    return new ReadAtRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReadAtRequest {
+    return new ReadAtRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReadAtRequest | PlainMessage<ReadAtRequest> | undefined, b: ReadAtRequest | PlainMessage<ReadAtRequest> | undefined): boolean {
+    return proto3.util.equals(ReadAtRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ReadAtResponse
+ */
+export class ReadAtResponse extends Message<ReadAtResponse> {
+  /**
+   * images that are not in the archive anymore are referenced by their hash and format only, their
+   * url and thumbnails are not set
+   *
+   * @generated from field: v1.EntryMetadata metadata = 1;
+   */
+  metadata?: EntryMetadata;
+
+  /**
+   * children will be defined if the entry is a container, otherwise it will be null
+   *
+   * @generated from field: optional v1.ReadResponse.Children children = 2;
+   */
+  children?: ReadResponse_Children;
+
+  /**
+   * revision is the revision the entry was read at
+   *
+   * @generated from field: v1.Revision revision = 3;
+   */
+  revision?: Revision;
+
+  constructor(data?: PartialMessage<ReadAtResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ReadAtResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: EntryMetadata },
+    { no: 2, name: "children", kind: "message", T: ReadResponse_Children, opt: true },
+    { no: 3, name: "revision", kind: "message", T: Revision },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReadAtResponse {
+    return new ReadAtResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReadAtResponse {
+    return new ReadAtResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReadAtResponse {
+    return new ReadAtResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReadAtResponse | PlainMessage<ReadAtResponse> | undefined, b: ReadAtResponse | PlainMessage<ReadAtResponse> | undefined): boolean {
+    return proto3.util.equals(ReadAtResponse, a, b);
+  }
+}
+
+/**
+ * StartAudit starts a stocktake of the items in a container and every container below it, the items
+ * are marked as seen one by one with MarkSeen and FinishAudit then compares what was seen with the
+ * archive
+ *
+ * audits keep track of entries by their uid, so entries can be moved or renamed while an audit is
+ * running, the items in scope are the ones in the container when MarkSeen or FinishAudit is called
+ *
+ * @generated from message v1.StartAuditRequest
+ */
+export class StartAuditRequest extends Message<StartAuditRequest> {
+  /**
+   * this should follow the same convention as the path in ReadRequest, an empty path audits the whole
+   * archive
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  constructor(data?: PartialMessage<StartAuditRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.StartAuditRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartAuditRequest {
+    return new StartAuditRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartAuditRequest {
+    return new StartAuditRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartAuditRequest {
+    return new StartAuditRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StartAuditRequest | PlainMessage<StartAuditRequest> | undefined, b: StartAuditRequest | PlainMessage<StartAuditRequest> | undefined): boolean {
+    return proto3.util.equals(StartAuditRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.StartAuditResponse
+ */
+export class StartAuditResponse extends Message<StartAuditResponse> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * expected is the number of items in scope
+   *
+   * @generated from field: uint32 expected = 2;
+   */
+  expected = 0;
+
+  constructor(data?: PartialMessage<StartAuditResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.StartAuditResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "expected", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartAuditResponse {
+    return new StartAuditResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartAuditResponse {
+    return new StartAuditResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartAuditResponse {
+    return new StartAuditResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StartAuditResponse | PlainMessage<StartAuditResponse> | undefined, b: StartAuditResponse | PlainMessage<StartAuditResponse> | undefined): boolean {
+    return proto3.util.equals(StartAuditResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.AuditItem
+ */
+export class AuditItem extends Message<AuditItem> {
+  /**
+   * @generated from field: v1.AuditItem.Status status = 1;
+   */
+  status = AuditItem_Status.MISSING;
+
+  /**
+   * path is where the item is in the archive, this follows the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 2;
+   */
+  path: string[] = [];
+
+  /**
+   * location is the container the item was seen in, it is empty for MISSING items
+   *
+   * @generated from field: repeated string location = 3;
+   */
+  location: string[] = [];
+
+  constructor(data?: PartialMessage<AuditItem>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.AuditItem";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "status", kind: "enum", T: proto3.getEnumType(AuditItem_Status) },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "location", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditItem {
+    return new AuditItem().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditItem {
+    return new AuditItem().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditItem {
+    return new AuditItem().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditItem | PlainMessage<AuditItem> | undefined, b: AuditItem | PlainMessage<AuditItem> | undefined): boolean {
+    return proto3.util.equals(AuditItem, a, b);
+  }
+}
+
+/**
+ * @generated from enum v1.AuditItem.Status
+ */
+export enum AuditItem_Status {
+  /**
+   * the item is in scope but has not been seen
+   *
+   * @generated from enum value: MISSING = 0;
+   */
+  MISSING = 0,
+
+  /**
+   * the item was seen in the container it is in
+   *
+   * @generated from enum value: CONFIRMED = 1;
+   */
+  CONFIRMED = 1,
+
+  /**
+   * the item was seen in another container than the one it is in, this includes items that are not
+   * in scope
+   *
+   * @generated from enum value: FOUND_ELSEWHERE = 2;
+   */
+  FOUND_ELSEWHERE = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AuditItem_Status)
+proto3.util.setEnumType(AuditItem_Status, "v1.AuditItem.Status", [
+  { no: 0, name: "MISSING" },
+  { no: 1, name: "CONFIRMED" },
+  { no: 2, name: "FOUND_ELSEWHERE" },
+]);
+
+/**
+ * MarkSeen records that an item was seen in a container during an audit, marking an item again
+ * replaces the location it was seen in
+ *
+ * @generated from message v1.MarkSeenRequest
+ */
+export class MarkSeenRequest extends Message<MarkSeenRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * path of the item, this should follow the same convention as the path in ReadRequest
+   *
+   * @generated from field: repeated string path = 2;
+   */
+  path: string[] = [];
+
+  /**
+   * location is the container the item was seen in, this should follow the same convention as the
+   * path in ReadRequest
+   *
+   * @generated from field: repeated string location = 3;
+   */
+  location: string[] = [];
+
+  constructor(data?: PartialMessage<MarkSeenRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.MarkSeenRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "location", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MarkSeenRequest {
+    return new MarkSeenRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MarkSeenRequest {
+    return new MarkSeenRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MarkSeenRequest {
+    return new MarkSeenRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MarkSeenRequest | PlainMessage<MarkSeenRequest> | undefined, b: MarkSeenRequest | PlainMessage<MarkSeenRequest> | undefined): boolean {
+    return proto3.util.equals(MarkSeenRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.MarkSeenResponse
+ */
+export class MarkSeenResponse extends Message<MarkSeenResponse> {
+  /**
+   * @generated from field: v1.AuditItem item = 1;
+   */
+  item?: AuditItem;
+
+  constructor(data?: PartialMessage<MarkSeenResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.MarkSeenResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "item", kind: "message", T: AuditItem },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MarkSeenResponse {
+    return new MarkSeenResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MarkSeenResponse {
+    return new MarkSeenResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MarkSeenResponse {
+    return new MarkSeenResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MarkSeenResponse | PlainMessage<MarkSeenResponse> | undefined, b: MarkSeenResponse | PlainMessage<MarkSeenResponse> | undefined): boolean {
+    return proto3.util.equals(MarkSeenResponse, a, b);
+  }
+}
+
+/**
+ * FinishAudit ends an audit and reports every item that is in scope or was seen
+ *
+ * @generated from message v1.FinishAuditRequest
+ */
+export class FinishAuditRequest extends Message<FinishAuditRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<FinishAuditRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.FinishAuditRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FinishAuditRequest {
+    return new FinishAuditRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FinishAuditRequest {
+    return new FinishAuditRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FinishAuditRequest {
+    return new FinishAuditRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FinishAuditRequest | PlainMessage<FinishAuditRequest> | undefined, b: FinishAuditRequest | PlainMessage<FinishAuditRequest> | undefined): boolean {
+    return proto3.util.equals(FinishAuditRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.FinishAuditResponse
+ */
+export class FinishAuditResponse extends Message<FinishAuditResponse> {
+  /**
+   * items are sorted by path
+   *
+   * @generated from field: repeated v1.AuditItem items = 1;
+   */
+  items: AuditItem[] = [];
+
+  /**
+   * moves are the moves that would put every FOUND_ELSEWHERE item where it was seen, they are not
+   * made by FinishAudit
+   *
+   * @generated from field: repeated v1.MoveRequest moves = 2;
+   */
+  moves: MoveRequest[] = [];
+
+  constructor(data?: PartialMessage<FinishAuditResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.FinishAuditResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "items", kind: "message", T: AuditItem, repeated: true },
+    { no: 2, name: "moves", kind: "message", T: MoveRequest, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FinishAuditResponse {
+    return new FinishAuditResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FinishAuditResponse {
+    return new FinishAuditResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FinishAuditResponse {
+    return new FinishAuditResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FinishAuditResponse | PlainMessage<FinishAuditResponse> | undefined, b: FinishAuditResponse | PlainMessage<FinishAuditResponse> | undefined): boolean {
+    return proto3.util.equals(FinishAuditResponse, a, b);
+  }
+}
+
+/**
+ * Watch streams changes made to the archive, including changes made outside of the service
+ *
+ * @generated from message v1.WatchRequest
+ */
+export class WatchRequest extends Message<WatchRequest> {
+  /**
+   * only changes to entries inside this subtree will be sent, this should follow the same
+   * convention as the path in ReadRequest, an empty path watches the whole archive
+   *
+   * @generated from field: repeated string path = 1;
+   */
+  path: string[] = [];
+
+  constructor(data?: PartialMessage<WatchRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.WatchRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchRequest {
+    return new WatchRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchRequest {
+    return new WatchRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchRequest {
+    return new WatchRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchRequest | PlainMessage<WatchRequest> | undefined, b: WatchRequest | PlainMessage<WatchRequest> | undefined): boolean {
+    return proto3.util.equals(WatchRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.WatchResponse
+ */
+export class WatchResponse extends Message<WatchResponse> {
+  /**
+   * @generated from field: v1.WatchResponse.EventType type = 1;
+   */
+  type = WatchResponse_EventType.CREATED;
+
+  /**
+   * path is the path of the entry after the change, for DELETED it is the path the entry was deleted from
+   *
+   * when a container is created, moved or deleted a single event is sent for the container,
+   * the same change implicitly applies to all of its children
+   *
+   * @generated from field: repeated string path = 2;
+   */
+  path: string[] = [];
+
+  /**
+   * src is the path the entry was moved from, it is only set for MOVED
+   *
+   * @generated from field: repeated string src = 3;
+   */
+  src: string[] = [];
+
+  /**
+   * @generated from field: bool is_container = 4;
+   */
+  isContainer = false;
+
+  constructor(data?: PartialMessage<WatchResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.WatchResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "enum", T: proto3.getEnumType(WatchResponse_EventType) },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "src", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "is_container", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchResponse {
+    return new WatchResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchResponse {
+    return new WatchResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchResponse {
+    return new WatchResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchResponse | PlainMessage<WatchResponse> | undefined, b: WatchResponse | PlainMessage<WatchResponse> | undefined): boolean {
+    return proto3.util.equals(WatchResponse, a, b);
+  }
+}
+
+/**
+ * @generated from enum v1.WatchResponse.EventType
+ */
+export enum WatchResponse_EventType {
+  /**
+   * @generated from enum value: CREATED = 0;
+   */
+  CREATED = 0,
+
+  /**
+   * @generated from enum value: MOVED = 1;
+   */
+  MOVED = 1,
+
+  /**
+   * @generated from enum value: DELETED = 2;
+   */
+  DELETED = 2,
+
+  /**
+   * @generated from enum value: UPDATED = 3;
+   */
+  UPDATED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(WatchResponse_EventType)
+proto3.util.setEnumType(WatchResponse_EventType, "v1.WatchResponse.EventType", [
+  { no: 0, name: "CREATED" },
+  { no: 1, name: "MOVED" },
+  { no: 2, name: "DELETED" },
+  { no: 3, name: "UPDATED" },
+]);
+
@@ -1,15 +1,18 @@
 import { createPromiseClient, type PromiseClient } from "@connectrpc/connect"
 import { ArchiveService } from "./api/v1/api_connect"
 import { createConnectTransport } from "@connectrpc/connect-web"
-import type { EntryMetadata } from "./api/v1/api_pb"
+import type { Attachment, EntryMetadata, WatchResponse } from "./api/v1/api_pb"
+
+export const serverUrl = import.meta.env.VITE_SERVER_URL !== "" ? import.meta.env.VITE_SERVER_URL : window.location.origin
 
 const transport = createConnectTransport({
-  baseUrl: import.meta.env.VITE_SERVER_URL !== "" ? import.meta.env.VITE_SERVER_URL : window.location.origin
+  baseUrl: serverUrl
 })
 
 export const remote = createPromiseClient(ArchiveService, transport)
 
 export interface Archive {
+  resolveID(uid: string): Promise<string[]>
   read(path: string[]): Promise<{
     metadata: EntryMetadata,
     children?: {
@@ -20,6 +23,9 @@ export interface Archive {
   create(metadata: EntryMetadata, path: string[], createContainer: boolean): Promise<void>
   move(src: string[], dst: string[]): Promise<void>
   delete(path: string[]): Promise<void>
+  watch(path: string[], onChange: (event: WatchResponse) => void, signal: AbortSignal): Promise<void>
+  listAttachments(path: string[]): Promise<Attachment[]>
+  downloadAttachment(path: string[], name: string): Promise<Blob>
 }
 
 export class RemoteArchive implements Archive {
@@ -29,6 +35,10 @@ export class RemoteArchive implements Archive {
     this.client = client
   }
 
+  async resolveID(uid: string): Promise<string[]> {
+    const res = await this.client.resolveID({ uid })
+    return res.path
+  }
   async read(path: string[]) {
     const res = await this.client.read({ path })
     return {
@@ -49,6 +59,26 @@ export class RemoteArchive implements Archive {
   async delete(path: string[]): Promise<void> {
     await this.client.delete({ path })
   }
+  async watch(path: string[], onChange: (event: WatchResponse) => void, signal: AbortSignal): Promise<void> {
+    for await (const event of this.client.watch({ path }, { signal })) {
+      onChange(event)
+    }
+  }
+  async listAttachments(path: string[]): Promise<Attachment[]> {
+    const res = await this.client.listAttachments({ path })
+    return res.attachments
+  }
+  async downloadAttachment(path: string[], name: string): Promise<Blob> {
+    const chunks: Uint8Array[] = []
+    let type = ""
+    for await (const res of this.client.downloadAttachment({ path, name })) {
+      if (res.attachment) {
+        type = res.attachment.mimeType
+      }
+      chunks.push(res.data)
+    }
+    return new Blob(chunks, { type })
+  }
 
 }
 
@@ -1,3 +1,40 @@
+import { Code, ConnectError } from "@connectrpc/connect";
+import { EntryError, EntryError_Reason } from "../api/v1/api_pb";
+
+const reasonMessages: Record<EntryError_Reason, string> = {
+  [EntryError_Reason.UNKNOWN]: "Something went wrong with this entry.",
+  [EntryError_Reason.NOT_FOUND]: "This entry does not exist anymore, it may have been moved or deleted.",
+  [EntryError_Reason.ALREADY_EXISTS]: "An entry with this name already exists here.",
+  [EntryError_Reason.INVALID_PATH]: "This is not a valid location for an entry.",
+  [EntryError_Reason.PERMISSION_DENIED]: "This entry cannot be changed.",
+  [EntryError_Reason.MOVE_INTO_SELF]: "A container cannot be moved into itself.",
+  [EntryError_Reason.IMAGE_NOT_FOUND]: "This image does not exist anymore, it may have been removed.",
+  [EntryError_Reason.ATTACHMENT_NOT_FOUND]: "This attachment does not exist anymore, it may have been removed.",
+  [EntryError_Reason.ATTACHMENT_ALREADY_EXISTS]: "An attachment with this name already exists on this entry.",
+  [EntryError_Reason.INSUFFICIENT_QUANTITY]: "There is not enough of this entry left.",
+  [EntryError_Reason.DIVERGED]: "This entry has been changed since, so the change cannot be undone or redone.",
+  [EntryError_Reason.CHECKED_OUT]: "This item is already checked out.",
+  [EntryError_Reason.NOT_CHECKED_OUT]: "This item is not checked out.",
+}
+
+const codeMessages: Partial<Record<Code, string>> = {
+  [Code.NotFound]: "This entry does not exist anymore, it may have been moved or deleted.",
+  [Code.AlreadyExists]: "An entry with this name already exists here.",
+  [Code.InvalidArgument]: "The request was invalid.",
+  [Code.PermissionDenied]: "This operation is not allowed.",
+}
+
 export function notifyError(err: Error) {
+  if (err instanceof ConnectError) {
+    const [detail] = err.findDetails(EntryError)
+    if (detail) {
+      const path = detail.path.length > 0 ? detail.path.join(" / ") : "(root)"
+      alert(`${reasonMessages[detail.reason]}\n\n${path}`)
+      return
+    }
+    const summary = codeMessages[err.code]
+    alert(summary ? `${summary}\n\n${err.rawMessage}` : err.rawMessage)
+    return
+  }
   alert(err.message)
 }